@@ -23,9 +23,16 @@ const (
 	BiuAttrErrCode    = "__BIU_ERROR_CODE__"
 	BiuAttrErrMsg     = "__BIU_ERROR_MESSAGE__"
 	BiuAttrErrArgs    = "__BIU_ERROR_ARGS__"
+	BiuAttrErrData    = "__BIU_ERROR_DATA__"
 	BiuAttrRouteID    = "__BIU_ROUTE_ID__"
 	BiuAttrAuthUserID = "__BIU_AUTH_USER_ID__"
 	BiuAttrEntities   = "__BIU_ENTITIES__"
+	BiuAttrRawResp    = "__BIU_RAW_RESPONSE__"
+	BiuAttrStatus     = "__BIU_STATUS__"
+	BiuAttrNoEnvelope = "__BIU_NO_ENVELOPE__"
+
+	BiuAttrMultipartLimits = "__BIU_MULTIPART_LIMITS__"
+	BiuAttrClosers         = "__BIU_CLOSERS__"
 )
 
 const CtxSignature = "github.com/tuotoo/biu/box.Ctx"
@@ -55,18 +62,51 @@ func (ctx *Ctx) ResponseJSON(v ...interface{}) {
 	ctx.SetAttribute(BiuAttrEntities, v)
 }
 
+// SetStatus sets the status code of a successful response, the default is 200.
+func (ctx *Ctx) SetStatus(code int) {
+	ctx.SetAttribute(BiuAttrStatus, code)
+}
+
 func (ctx *Ctx) Transform(f func(...interface{}) []interface{}) {
 	if entities, ok := ctx.Attribute(BiuAttrEntities).([]interface{}); ok {
 		ctx.SetAttribute(BiuAttrEntities, f(entities...))
 	}
 }
 
+// RawResponse marks that the response is written by the handler itself,
+// so DefaultResponseTransformer and DefaultErrorTransformer will not
+// write a CommonResp after it.
+func (ctx *Ctx) RawResponse() {
+	ctx.SetAttribute(BiuAttrRawResp, true)
+}
+
+// IsRawResponse reports whether the response is written by the handler itself.
+func (ctx *Ctx) IsRawResponse() bool {
+	raw, _ := ctx.Attribute(BiuAttrRawResp).(bool)
+	return raw
+}
+
 // ResponseError is a convenience method to response an error code and message.
 func (ctx *Ctx) ResponseError(code int, msg string) {
 	ctx.SetAttribute(BiuAttrErrCode, code)
 	ctx.SetAttribute(BiuAttrErrMsg, msg)
 }
 
+// ResponseErrorData is a convenience method to response an error code and message
+// with data, if data is an error, it will be logged as well.
+func (ctx *Ctx) ResponseErrorData(code int, msg string, data interface{}) {
+	ctx.ResponseError(code, msg)
+	ctx.SetAttribute(BiuAttrErrData, data)
+	if err, ok := data.(error); ok {
+		ctx.SetAttribute(BiuAttrErr, err)
+	}
+}
+
+// ResponseFieldErrors responses an error code with the field errors as data.
+func (ctx *Ctx) ResponseFieldErrors(code int, errs FieldErrors) {
+	ctx.ResponseErrorData(code, "invalid parameters", errs)
+}
+
 // RouteID returns the RouteID of current route.
 func (ctx *Ctx) RouteID() string {
 	return ctx.Attribute(BiuAttrRouteID).(string)
@@ -171,7 +211,7 @@ func (ctx *Ctx) Proxy() []string {
 // BodyParameterValues returns the array of parameter in a POST form body.
 func (ctx *Ctx) BodyParameterValues(name string) ([]string, error) {
 	if strings.HasPrefix(ctx.Req().Header.Get(restful.HEADER_ContentType), "multipart/form-data") {
-		err := ctx.Req().ParseMultipartForm(defaultMaxMemory)
+		err := ctx.ParseMultipartForm()
 		if err != nil {
 			return []string{}, err
 		}
@@ -207,6 +247,20 @@ func (ctx *Ctx) Header(name string) param.Parameter {
 	return param.NewParameter([]string{ctx.HeaderParameter(name)}, nil)
 }
 
+// Cookie reads cookie parameter with name.
+func (ctx *Ctx) Cookie(name string) param.Parameter {
+	c, err := ctx.Req().Cookie(name)
+	if err != nil {
+		return param.NewParameter(nil, nil)
+	}
+	return param.NewParameter([]string{c.Value}, nil)
+}
+
+// SetCookie adds a Set-Cookie header to the response.
+func (ctx *Ctx) SetCookie(c *http.Cookie) {
+	http.SetCookie(ctx.Resp(), c)
+}
+
 func filterFlags(content string) string {
 	for i, char := range content {
 		if char == ' ' || char == ';' {
@@ -0,0 +1,76 @@
+package box
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrNoContent is logged when the content to serve is nil,
+// the request is responded with the error code 500.
+var ErrNoContent = errors.New("content to serve is nil")
+
+// ServeContent replies to the request using the content in the provided ReadSeeker.
+// It handles Range, If-Match, If-Unmodified-Since, If-None-Match,
+// If-Modified-Since and If-Range requests,
+// and the response will not be wrapped in CommonResp.
+func (ctx *Ctx) ServeContent(name string, modtime time.Time, content io.ReadSeeker) {
+	if content == nil {
+		ctx.ResponseError(http.StatusInternalServerError, ErrNoContent.Error())
+		ctx.SetAttribute(BiuAttrErr, ErrNoContent)
+		return
+	}
+	ctx.RawResponse()
+	http.ServeContent(ctx.Response, ctx.Req(), name, modtime, content)
+}
+
+// Attachment serves the content as an attachment to be saved with name.
+func (ctx *Ctx) Attachment(name string, modtime time.Time, content io.ReadSeeker) {
+	ctx.ServeDownload(Download{
+		Name:    name,
+		ModTime: modtime,
+		Content: content,
+	})
+}
+
+// ServeDownload serves a Download with its ETag and Content-Disposition.
+func (ctx *Ctx) ServeDownload(d Download) {
+	if d.Content == nil {
+		ctx.ServeContent(d.Name, d.ModTime, nil)
+		return
+	}
+	header := ctx.Resp().Header()
+	if d.ETag != "" {
+		etag := d.ETag
+		if !strings.HasPrefix(etag, `"`) && !strings.HasPrefix(etag, `W/"`) {
+			etag = `"` + etag + `"`
+		}
+		header.Set("ETag", etag)
+	}
+	if d.ContentType != "" {
+		header.Set("Content-Type", d.ContentType)
+	}
+	disposition := "attachment"
+	if d.Inline {
+		disposition = "inline"
+	}
+	header.Set("Content-Disposition", contentDisposition(disposition, d.Name))
+	ctx.ServeContent(d.Name, d.ModTime, d.Content)
+}
+
+// contentDisposition formats the header with filename,
+// non-ASCII names are encoded as RFC 2231 filename*.
+func contentDisposition(disposition, name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" {
+		return disposition
+	}
+	if v := mime.FormatMediaType(disposition, map[string]string{"filename": name}); v != "" {
+		return v
+	}
+	return disposition
+}
@@ -0,0 +1,76 @@
+package box_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+var downloadModTime = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+type downloadCtl struct{}
+
+func (ctl downloadCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/content"), opt.RouteTo(func(ctx box.Ctx) {
+		ctx.ServeContent("a.txt", downloadModTime, strings.NewReader("0123456789"))
+	}))
+	ws.Route(ws.GET("/attachment"), opt.RouteTo(func(ctx box.Ctx) {
+		ctx.Attachment("报告.txt", downloadModTime, strings.NewReader("report"))
+	}))
+	ws.Route(ws.GET("/empty"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(box.Download)
+	}) {
+		api.Return(box.Download{Name: "a.csv", ETag: "v1"})
+	}))
+	ws.Route(ws.GET("/api"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(box.Download)
+	}) {
+		api.Return(box.Download{
+			Name:    "a.csv",
+			Content: strings.NewReader("a,b"),
+			ETag:    "v1",
+			Inline:  true,
+		})
+	}))
+}
+
+func TestCtx_ServeContent(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{
+		NameSpace:  "download",
+		Controller: downloadCtl{},
+	})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.GET("/download/content").Expect().Status(http.StatusOK).
+		HasContentType("text/plain").Body().IsEqual("0123456789")
+	e.GET("/download/content").WithHeader("Range", "bytes=2-4").
+		Expect().Status(http.StatusPartialContent).Body().IsEqual("234")
+	e.GET("/download/content").
+		WithHeader("If-Modified-Since", downloadModTime.Format(http.TimeFormat)).
+		Expect().Status(http.StatusNotModified)
+
+	e.GET("/download/attachment").Expect().Status(http.StatusOK).
+		Header("Content-Disposition").IsEqual("attachment; filename*=utf-8''%E6%8A%A5%E5%91%8A.txt")
+
+	resp := e.GET("/download/api").Expect().Status(http.StatusOK)
+	resp.Header("ETag").IsEqual(`"v1"`)
+	resp.Header("Content-Disposition").IsEqual(`inline; filename=a.csv`)
+	resp.Body().IsEqual("a,b")
+	e.GET("/download/api").WithHeader("If-None-Match", `"v1"`).
+		Expect().Status(http.StatusNotModified)
+
+	empty := e.GET("/download/empty").Expect()
+	empty.Headers().NotContainsKey("Etag")
+	empty.JSON().Object().HasValue("code", http.StatusInternalServerError).
+		HasValue("message", box.ErrNoContent.Error())
+}
@@ -0,0 +1,27 @@
+package box
+
+import "fmt"
+
+// Error is an error with a code in ErrorMap,
+// Args are used to format the message of code.
+type Error struct {
+	Code int
+	Err  error
+	Args []interface{}
+}
+
+// NewError creates an Error with code, err is logged but not responded.
+func NewError(code int, err error, args ...interface{}) *Error {
+	return &Error{Code: code, Err: err, Args: args}
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("error code %d", e.Code)
+	}
+	return fmt.Sprintf("error code %d: %v", e.Code, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
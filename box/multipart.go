@@ -0,0 +1,201 @@
+package box
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+)
+
+// ErrPartTooLarge is returned when a multipart part exceeds MultipartLimits.MaxPartSize.
+var ErrPartTooLarge = errors.New("multipart part is too large")
+
+// MultipartLimits limits the parsing of multipart form of a route.
+type MultipartLimits struct {
+	// MaxMemory is the max bytes of file parts stored in memory,
+	// the rest will be stored in temporary files.
+	MaxMemory int64
+	// MaxPartSize is the max bytes of each part, 0 means unlimited.
+	MaxPartSize int64
+}
+
+func (ctx *Ctx) multipartLimits() MultipartLimits {
+	limits, _ := ctx.Attribute(BiuAttrMultipartLimits).(MultipartLimits)
+	if limits.MaxMemory <= 0 {
+		limits.MaxMemory = defaultMaxMemory
+	}
+	return limits
+}
+
+// ParseMultipartForm parses the multipart form with the limits of route.
+// The form is only parsed once, and temporary files will be removed
+// when the request ends. Parts beyond MultipartLimits.MaxPartSize
+// are rejected while they are read, before they are stored.
+func (ctx *Ctx) ParseMultipartForm() error {
+	r := ctx.Req()
+	limits := ctx.multipartLimits()
+	if r.MultipartForm != nil || limits.MaxPartSize <= 0 {
+		return r.ParseMultipartForm(limits.MaxMemory)
+	}
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || params["boundary"] == "" || r.Body == nil {
+		return r.ParseMultipartForm(limits.MaxMemory)
+	}
+	// The parts are copied through a pipe with their sizes limited,
+	// the boundary is kept so the copy is parsed as the original body.
+	body := r.Body
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+	if err := w.SetBoundary(params["boundary"]); err != nil {
+		return r.ParseMultipartForm(limits.MaxMemory)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = pw.CloseWithError(copyParts(w, multipart.NewReader(body, params["boundary"]), limits.MaxPartSize))
+	}()
+	r.Body = pr
+	err = r.ParseMultipartForm(limits.MaxMemory)
+	// The copy may still be reading the body if the parsing stops early,
+	// it is stopped before the body is restored.
+	_ = pr.CloseWithError(err)
+	<-done
+	r.Body = body
+	return err
+}
+
+// copyParts copies the raw parts of r to w,
+// it returns ErrPartTooLarge if a part is larger than maxSize.
+func copyParts(w *multipart.Writer, r *multipart.Reader, maxSize int64) error {
+	for {
+		p, err := r.NextRawPart()
+		if errors.Is(err, io.EOF) {
+			return w.Close()
+		}
+		if err != nil {
+			return err
+		}
+		dst, err := w.CreatePart(p.Header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(dst, &Part{Part: p, maxSize: maxSize}); err != nil {
+			if errors.Is(err, ErrPartTooLarge) {
+				return fmt.Errorf("%w: %s", ErrPartTooLarge, p.FormName())
+			}
+			return err
+		}
+	}
+}
+
+// FormFile returns the first file uploaded with name.
+func (ctx *Ctx) FormFile(name string) (File, error) {
+	files, err := ctx.FormFiles(name)
+	if err != nil {
+		return File{}, err
+	}
+	if len(files) == 0 {
+		return File{}, http.ErrMissingFile
+	}
+	return files[0], nil
+}
+
+// FormFiles returns all the files uploaded with name.
+// The files will be closed when the request ends.
+func (ctx *Ctx) FormFiles(name string) ([]File, error) {
+	if err := ctx.ParseMultipartForm(); err != nil {
+		return nil, err
+	}
+	headers := ctx.Req().MultipartForm.File[name]
+	files := make([]File, 0, len(headers))
+	for _, h := range headers {
+		f, err := h.Open()
+		if err != nil {
+			return nil, err
+		}
+		ctx.closeOnFinish(f)
+		files = append(files, File{File: f, Header: h})
+	}
+	return files, nil
+}
+
+func (ctx *Ctx) closeOnFinish(c io.Closer) {
+	closers, _ := ctx.Attribute(BiuAttrClosers).([]io.Closer)
+	ctx.SetAttribute(BiuAttrClosers, append(closers, c))
+}
+
+// Finish closes the files opened by FormFile and FormFiles,
+// and removes the temporary files of multipart form.
+// It is called automatically after the route function returns.
+func (ctx *Ctx) Finish() {
+	if closers, ok := ctx.Attribute(BiuAttrClosers).([]io.Closer); ok {
+		for _, c := range closers {
+			_ = c.Close()
+		}
+		ctx.SetAttribute(BiuAttrClosers, nil)
+	}
+	if form := ctx.Req().MultipartForm; form != nil {
+		_ = form.RemoveAll()
+	}
+}
+
+// MultipartReader returns a streaming reader of the multipart body.
+// Parts are neither buffered in memory nor stored in temporary files,
+// so it can not be used together with Form, FormFile and FormFiles.
+func (ctx *Ctx) MultipartReader() (*PartReader, error) {
+	r, err := ctx.Req().MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	return &PartReader{
+		reader:      r,
+		maxPartSize: ctx.multipartLimits().MaxPartSize,
+	}, nil
+}
+
+// PartReader iterates over the parts of a multipart body.
+type PartReader struct {
+	reader      *multipart.Reader
+	maxPartSize int64
+}
+
+// NextPart returns the next part, or io.EOF if there are no more parts.
+// The previous part will be discarded.
+func (r *PartReader) NextPart() (*Part, error) {
+	p, err := r.reader.NextPart()
+	if err != nil {
+		return nil, err
+	}
+	return &Part{Part: p, maxSize: r.maxPartSize}, nil
+}
+
+// Part is a part of multipart body,
+// reading beyond MultipartLimits.MaxPartSize returns ErrPartTooLarge.
+type Part struct {
+	*multipart.Part
+	maxSize int64
+	read    int64
+}
+
+// Read reads the body of part.
+func (p *Part) Read(b []byte) (int, error) {
+	if p.maxSize <= 0 {
+		return p.Part.Read(b)
+	}
+	if p.read >= p.maxSize {
+		// a part of exactly maxSize bytes ends here, otherwise it is too large
+		var one [1]byte
+		if _, err := io.ReadFull(p.Part, one[:]); err != nil {
+			return 0, err
+		}
+		return 0, ErrPartTooLarge
+	}
+	if rest := p.maxSize - p.read; int64(len(b)) > rest {
+		b = b[:rest]
+	}
+	n, err := p.Part.Read(b)
+	p.read += int64(n)
+	return n, err
+}
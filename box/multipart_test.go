@@ -0,0 +1,166 @@
+package box_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+type uploadCtl struct {
+	tmpFiles int
+}
+
+func (ctl *uploadCtl) WebService(ws biu.WS) {
+	ws.Route(ws.POST("/files").Consumes(biu.MIME_FILE_FORM),
+		opt.MultipartMemory(1),
+		opt.MaxPartSize(8),
+		opt.RouteTo(func(ctx box.Ctx) {
+			files, err := ctx.FormFiles("f")
+			if errors.Is(err, box.ErrPartTooLarge) {
+				ctx.Must(err, 2)
+			}
+			ctx.Must(err, 1)
+			entries, err := os.ReadDir(os.TempDir())
+			ctx.Must(err, 1)
+			ctl.tmpFiles = len(entries)
+			var names []string
+			for _, f := range files {
+				bs, err := io.ReadAll(f)
+				ctx.Must(err, 1)
+				names = append(names, f.Header.Filename+"="+string(bs))
+			}
+			ctx.ResponseJSON(strings.Join(names, ","))
+		}),
+		opt.RouteErrors(map[int]string{1: "upload failed", 2: "part too large"}),
+	)
+	ws.Route(ws.POST("/stream").Consumes(biu.MIME_FILE_FORM),
+		opt.MaxPartSize(8),
+		opt.RouteTo(func(ctx box.Ctx) {
+			r, err := ctx.MultipartReader()
+			ctx.Must(err, 1)
+			var sizes []string
+			for {
+				p, err := r.NextPart()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				ctx.Must(err, 1)
+				bs, err := io.ReadAll(p)
+				if errors.Is(err, box.ErrPartTooLarge) {
+					ctx.Must(err, 2)
+				}
+				ctx.Must(err, 1)
+				sizes = append(sizes, p.FormName()+"="+string(bs))
+			}
+			ctx.ResponseJSON(strings.Join(sizes, ","))
+		}),
+		opt.RouteErrors(map[int]string{1: "upload failed", 2: "part too large"}),
+	)
+	ws.Route(ws.POST("/parts").Consumes(biu.MIME_FILE_FORM),
+		opt.MaxPartSize(8),
+		opt.RouteTo(func(ctx box.Ctx) {
+			err := ctx.ParseMultipartForm()
+			_, _ = io.Copy(io.Discard, ctx.Req().Body)
+			ctx.Must(err, 1)
+		}),
+		opt.RouteErrors(map[int]string{1: "upload failed"}),
+	)
+	ws.Route(ws.POST("/limited"),
+		opt.MaxBodySize(4),
+		opt.RouteTo(func(ctx box.Ctx) {
+			_, err := io.ReadAll(ctx.Req().Body)
+			ctx.Must(err, 3)
+		}),
+		opt.RouteErrors(map[int]string{3: "body too large"}),
+	)
+}
+
+func multipartBody(t *testing.T, files map[string][]string) (io.Reader, string) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	for field, contents := range files {
+		for i, content := range contents {
+			fw, err := w.CreateFormFile(field, field+string(rune('a'+i)))
+			assert.NoError(t, err)
+			_, err = fw.Write([]byte(content))
+			assert.NoError(t, err)
+		}
+	}
+	assert.NoError(t, w.Close())
+	return &b, w.FormDataContentType()
+}
+
+func TestCtx_Multipart(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+	c := biu.New()
+	ctl := &uploadCtl{}
+	c.AddServices("", nil, biu.NS{
+		NameSpace:  "upload",
+		Controller: ctl,
+	})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	body, contentType := multipartBody(t, map[string][]string{"f": {"123", "4567"}})
+	e.POST("/upload/files").WithHeader("Content-Type", contentType).WithBytes(readAll(t, body)).
+		Expect().JSON().Object().HasValue("code", 0).HasValue("data", "fa=123,fb=4567")
+	assert.NotZero(t, ctl.tmpFiles)
+	entries, err := os.ReadDir(tmpDir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries, "temporary files are not removed")
+
+	body, contentType = multipartBody(t, map[string][]string{"f": {"12345678"}})
+	e.POST("/upload/files").WithHeader("Content-Type", contentType).WithBytes(readAll(t, body)).
+		Expect().JSON().Object().HasValue("code", 0).HasValue("data", "fa=12345678")
+
+	body, contentType = multipartBody(t, map[string][]string{"f": {"123456789"}})
+	e.POST("/upload/files").WithHeader("Content-Type", contentType).WithBytes(readAll(t, body)).
+		Expect().JSON().Object().HasValue("code", 2)
+
+	body, contentType = multipartBody(t, map[string][]string{"f": {"123"}, "g": {"45"}})
+	resp := e.POST("/upload/stream").WithHeader("Content-Type", contentType).WithBytes(readAll(t, body)).
+		Expect().JSON().Object().HasValue("code", 0)
+	resp.Value("data").String().Contains("f=123").Contains("g=45")
+
+	body, contentType = multipartBody(t, map[string][]string{"f": {"12345678"}})
+	e.POST("/upload/stream").WithHeader("Content-Type", contentType).WithBytes(readAll(t, body)).
+		Expect().JSON().Object().HasValue("code", 0).HasValue("data", "f=12345678")
+
+	body, contentType = multipartBody(t, map[string][]string{"f": {"123456789"}})
+	e.POST("/upload/stream").WithHeader("Content-Type", contentType).WithBytes(readAll(t, body)).
+		Expect().JSON().Object().HasValue("code", 2)
+
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	for i := 0; i < 2000; i++ {
+		assert.NoError(t, w.WriteField("v", "1"))
+	}
+	assert.NoError(t, w.Close())
+	e.POST("/upload/parts").WithHeader("Content-Type", w.FormDataContentType()).WithBytes(b.Bytes()).
+		Expect().JSON().Object().HasValue("code", 1)
+
+	e.POST("/upload/limited").WithHeader("Content-Type", biu.MIME_HTML_FORM).WithText("12345").
+		Expect().JSON().Object().HasValue("code", 3)
+	e.POST("/upload/limited").WithHeader("Content-Type", biu.MIME_HTML_FORM).WithText("1234").
+		Expect().Status(http.StatusOK)
+}
+
+func readAll(t *testing.T, r io.Reader) []byte {
+	bs, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	return bs
+}
@@ -0,0 +1,179 @@
+package box
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const mimeEventStream = "text/event-stream"
+
+// ErrStreamClosed is returned when writing to a closed EventStream.
+var ErrStreamClosed = errors.New("event stream is closed")
+
+// Event is a message of Server-Sent Events.
+// Data will be written as is if it is a string or []byte,
+// otherwise it will be encoded as JSON.
+type Event struct {
+	ID    string
+	Event string
+	Data  interface{}
+	Retry time.Duration
+}
+
+// EventStream writes Server-Sent Events to the client.
+type EventStream struct {
+	ctx         *Ctx
+	lastEventID string
+	mu          sync.Mutex
+	closed      bool
+	done        chan struct{}
+	wg          sync.WaitGroup
+}
+
+// SSE starts a Server-Sent Events stream.
+// The response will not be wrapped in CommonResp,
+// and the stream must be closed before the handler returns.
+func (ctx *Ctx) SSE() *EventStream {
+	ctx.RawResponse()
+	header := ctx.Resp().Header()
+	header.Set("Content-Type", mimeEventStream)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	ctx.Response.WriteHeader(http.StatusOK)
+	ctx.Response.Flush()
+
+	s := &EventStream{
+		ctx:         ctx,
+		lastEventID: ctx.HeaderParameter("Last-Event-ID"),
+		done:        make(chan struct{}),
+	}
+	reqDone := ctx.Req().Context().Done()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		select {
+		case <-reqDone:
+			s.stop()
+		case <-s.done:
+		}
+	}()
+	return s
+}
+
+// LastEventID returns the Last-Event-ID header sent by a reconnecting client,
+// it can be used to resume the stream.
+func (s *EventStream) LastEventID() string {
+	return s.lastEventID
+}
+
+// Done returns a channel that is closed when the client disconnects
+// or the stream is closed.
+func (s *EventStream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Send writes an event to the client and flushes it.
+func (s *EventStream) Send(e Event) error {
+	var buf bytes.Buffer
+	if e.ID != "" {
+		buf.WriteString("id: " + singleLine(e.ID) + "\n")
+	}
+	if e.Event != "" {
+		buf.WriteString("event: " + singleLine(e.Event) + "\n")
+	}
+	if e.Retry > 0 {
+		buf.WriteString("retry: " + strconv.FormatInt(e.Retry.Milliseconds(), 10) + "\n")
+	}
+	if e.Data != nil {
+		var data string
+		switch v := e.Data.(type) {
+		case string:
+			data = v
+		case []byte:
+			data = string(v)
+		default:
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			data = string(bs)
+		}
+		for _, line := range strings.Split(data, "\n") {
+			buf.WriteString("data: " + strings.TrimSuffix(line, "\r") + "\n")
+		}
+	}
+	buf.WriteString("\n")
+	return s.write(buf.Bytes())
+}
+
+// Comment writes a comment line which is ignored by clients.
+func (s *EventStream) Comment(comment string) error {
+	return s.write([]byte(": " + singleLine(comment) + "\n\n"))
+}
+
+// Heartbeat writes a comment to the client every interval
+// to keep the connection alive, until the stream is closed.
+// No heartbeat is sent if interval is not positive.
+func (s *EventStream) Heartbeat(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				if err := s.Comment("heartbeat"); err != nil {
+					s.stop()
+					return
+				}
+			}
+		}
+	}()
+}
+
+// Close stops the stream and waits for the heartbeat to exit.
+// It should be called before the handler returns,
+// and it is safe to call Close more than once.
+func (s *EventStream) Close() {
+	s.stop()
+	s.wg.Wait()
+}
+
+func (s *EventStream) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+}
+
+func (s *EventStream) write(bs []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrStreamClosed
+	}
+	if _, err := s.ctx.Response.Write(bs); err != nil {
+		return err
+	}
+	s.ctx.Response.Flush()
+	return nil
+}
+
+func singleLine(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
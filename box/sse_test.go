@@ -0,0 +1,122 @@
+package box_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+type sseCtl struct {
+	disconnected chan struct{}
+}
+
+func (ctl *sseCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/events"),
+		opt.EventStream(),
+		opt.RouteTo(func(ctx box.Ctx) {
+			s := ctx.SSE()
+			defer s.Close()
+			s.Heartbeat(5 * time.Millisecond)
+			_ = s.Send(box.Event{
+				ID:    "2",
+				Event: "progress",
+				Data:  map[string]int{"percent": 50},
+				Retry: time.Second,
+			})
+			_ = s.Send(box.Event{Data: "resume from " + s.LastEventID() + "\nbye"})
+			time.Sleep(30 * time.Millisecond)
+		}),
+	)
+	ws.Route(ws.GET("/quiet"),
+		opt.EventStream(),
+		opt.RouteTo(func(ctx box.Ctx) {
+			s := ctx.SSE()
+			defer s.Close()
+			s.Heartbeat(0)
+			_ = s.Send(box.Event{Data: "quiet"})
+			time.Sleep(10 * time.Millisecond)
+		}),
+	)
+	ws.Route(ws.GET("/wait"),
+		opt.EventStream(),
+		opt.RouteTo(func(ctx box.Ctx) {
+			s := ctx.SSE()
+			defer s.Close()
+			<-s.Done()
+			close(ctl.disconnected)
+		}),
+	)
+}
+
+func newSSEServer() (*httptest.Server, *sseCtl) {
+	c := biu.New()
+	ctl := &sseCtl{disconnected: make(chan struct{})}
+	c.AddServices("", nil, biu.NS{
+		NameSpace:  "sse",
+		Controller: ctl,
+	})
+	return httptest.NewServer(c), ctl
+}
+
+func TestCtx_SSE(t *testing.T) {
+	s, _ := newSSEServer()
+	defer s.Close()
+
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/sse/events", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Accept", biu.MIME_EVENT_STREAM)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, biu.MIME_EVENT_STREAM, resp.Header.Get("Content-Type"))
+
+	bs, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	body := string(bs)
+	assert.True(t, strings.HasPrefix(body,
+		"id: 2\nevent: progress\nretry: 1000\ndata: {\"percent\":50}\n\n"+
+			"data: resume from 1\ndata: bye\n\n"), body)
+	assert.Contains(t, body, ": heartbeat\n\n")
+	assert.NotContains(t, body, "route_id")
+}
+
+func TestCtx_SSEDisconnect(t *testing.T) {
+	s, ctl := newSSEServer()
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL+"/sse/wait", nil)
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	cancel()
+
+	select {
+	case <-ctl.disconnected:
+	case <-time.After(time.Second):
+		t.Error("handler is not notified of disconnection")
+	}
+}
+
+func TestEventStream_HeartbeatDisabled(t *testing.T) {
+	s, _ := newSSEServer()
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/sse/quiet")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	bs, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "data: quiet\n\n", string(bs))
+}
@@ -1,6 +1,12 @@
 package box
 
-import "mime/multipart"
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
 
 // CommonResp with code, message and data
 type CommonResp struct {
@@ -16,3 +22,48 @@ type File struct {
 	multipart.File
 	Header *multipart.FileHeader
 }
+
+const RespMetaSignature = "github.com/tuotoo/biu/box.RespMeta"
+
+// RespMeta sets the status and headers of response,
+// it can be the second argument of RouteAPI Return.
+type RespMeta struct {
+	Status int
+	Header http.Header
+}
+
+const DownloadSignature = "github.com/tuotoo/biu/box.Download"
+
+// Download is a file response,
+// it will be documented as a file in swagger when used in RouteAPI Return.
+// A nil Content is responded as the error code 500 with ErrNoContent.
+type Download struct {
+	Name        string
+	ModTime     time.Time
+	Content     io.ReadSeeker
+	ContentType string
+	ETag        string
+	Inline      bool
+}
+
+// FieldError describes a parameter which failed in validation.
+type FieldError struct {
+	Field   string `json:"field"`
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
+
+// FieldErrors is a list of FieldError, it will be responded as the data of CommonResp.
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, v := range e {
+		name := v.In
+		if v.Field != "" {
+			name += "." + v.Field
+		}
+		msgs = append(msgs, name+": "+v.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,74 @@
+// Command biudiff reports the changes between two API documents of biu,
+// which are swagger 2.0 or OpenAPI 3 in JSON or YAML, e.g. written by biuspec:
+//
+//	biudiff [-json] old.yaml new.yaml
+//
+// Each change is classified as breaking or not for clients.
+// The exit code is 1 if any change is breaking, and 2 if the documents can not be read.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tuotoo/biu/openapi"
+	"github.com/tuotoo/biu/specdiff"
+)
+
+func main() {
+	asJSON := flag.Bool("json", false, "write the report in JSON")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: biudiff [-json] old new")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	breaking, err := diff(os.Stdout, flag.Arg(0), flag.Arg(1), *asJSON)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if breaking {
+		os.Exit(1)
+	}
+}
+
+// diff writes the report of changes from the document of oldPath to newPath,
+// and reports whether any of them is breaking.
+func diff(w io.Writer, oldPath, newPath string, asJSON bool) (bool, error) {
+	old, err := load(oldPath)
+	if err != nil {
+		return false, err
+	}
+	cur, err := load(newPath)
+	if err != nil {
+		return false, err
+	}
+	report := specdiff.Compare(old, cur)
+	if asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		_, err = io.WriteString(w, report.String())
+	}
+	return report.Breaking(), err
+}
+
+func load(path string) (*openapi.Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := openapi.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return doc, nil
+}
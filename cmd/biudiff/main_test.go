@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	oldDoc = `{
+  "swagger": "2.0",
+  "paths": {
+    "/users": {"get": {"responses": {"200": {"description": "ok"}}}},
+    "/users/{id}": {"delete": {"responses": {"200": {"description": "ok"}}}}
+  }
+}`
+	newDoc = `openapi: 3.1.0
+paths:
+  /users:
+    get:
+      responses:
+        200:
+          description: ok
+    post:
+      responses:
+        201:
+          description: created
+`
+)
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	oldPath, newPath := filepath.Join(dir, "old.json"), filepath.Join(dir, "new.yaml")
+	assert.NoError(t, os.WriteFile(oldPath, []byte(oldDoc), 0o644))
+	assert.NoError(t, os.WriteFile(newPath, []byte(newDoc), 0o644))
+
+	var buf bytes.Buffer
+	breaking, err := diff(&buf, oldPath, newPath, false)
+	assert.NoError(t, err)
+	assert.True(t, breaking)
+	assert.Equal(t, `[non-breaking] POST /users: route added
+[breaking] DELETE /users/{id}: route removed
+1 breaking, 1 non-breaking changes
+`, buf.String())
+
+	buf.Reset()
+	breaking, err = diff(&buf, newPath, newPath, true)
+	assert.NoError(t, err)
+	assert.False(t, breaking)
+	assert.JSONEq(t, `{"changes": []}`, buf.String())
+
+	buf.Reset()
+	breaking, err = diff(&buf, oldPath, newPath, true)
+	assert.NoError(t, err)
+	assert.True(t, breaking)
+	assert.JSONEq(t, `{"changes": [
+		{"breaking": false, "method": "POST", "path": "/users", "message": "route added"},
+		{"breaking": true, "method": "DELETE", "path": "/users/{id}", "message": "route removed"}
+	]}`, buf.String())
+
+	_, err = diff(&buf, filepath.Join(dir, "missing.json"), newPath, false)
+	assert.Error(t, err)
+}
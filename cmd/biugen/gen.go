@@ -0,0 +1,553 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tuotoo/biu/internal"
+)
+
+const directive = "//biu:route "
+
+var (
+	methods = map[string]bool{
+		"GET": true, "POST": true, "PUT": true, "PATCH": true,
+		"DELETE": true, "HEAD": true, "OPTIONS": true,
+	}
+	groups       = []string{"Header", "Cookie", "Path", "Query", "Form"}
+	paramMethods = map[string]string{
+		"string": "String", "bool": "Bool",
+		"int": "Int", "int8": "Int8", "int16": "Int16", "int32": "Int32", "int64": "Int64",
+		"uint": "Uint", "uint8": "Uint8", "byte": "Uint8", "uint16": "Uint16",
+		"uint32": "Uint32", "uint64": "Uint64",
+		"float32": "Float32", "float64": "Float64",
+	}
+)
+
+type controller struct {
+	name    string
+	routes  []*route
+	pointer bool
+}
+
+type route struct {
+	method string
+	path   string
+	fn     string
+	api    string
+	lines  []string
+}
+
+type generator struct {
+	fset        *token.FileSet
+	pkg         string
+	types       map[string]*ast.TypeSpec
+	controllers map[string]*controller
+	imports     map[string]string
+}
+
+// generate returns the source of static bindings for the package in dir,
+// output is the name of the generated file, which is not parsed.
+func generate(dir, output string) ([]byte, error) {
+	g := &generator{
+		fset:        token.NewFileSet(),
+		types:       make(map[string]*ast.TypeSpec),
+		controllers: make(map[string]*controller),
+		imports: map[string]string{
+			"biu": "github.com/tuotoo/biu",
+			"box": "github.com/tuotoo/biu/box",
+			"opt": "github.com/tuotoo/biu/opt",
+		},
+	}
+	pkgs, err := parser.ParseDir(g.fset, dir, func(info fs.FileInfo) bool {
+		return info.Name() != output && !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("biugen: expected one package in %s, found %d", dir, len(pkgs))
+	}
+	var files []*ast.File
+	for name, pkg := range pkgs {
+		g.pkg = name
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return g.fset.Position(files[i].Pos()).Filename < g.fset.Position(files[j].Pos()).Filename
+	})
+	for _, file := range files {
+		g.addImports(file)
+		for _, decl := range file.Decls {
+			if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					g.types[ts.Name.Name] = ts
+				}
+			}
+		}
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if err := g.parseFunc(fd); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g.render()
+}
+
+func (g *generator) parseFunc(fd *ast.FuncDecl) error {
+	var method, subPath string
+	if fd.Doc != nil {
+		for _, c := range fd.Doc.List {
+			if !strings.HasPrefix(c.Text, directive) {
+				continue
+			}
+			fields := strings.Fields(strings.TrimPrefix(c.Text, directive))
+			if len(fields) != 2 || !methods[fields[0]] {
+				return g.errorf(c.Pos(), "invalid directive %q, expected //biu:route METHOD PATH", c.Text)
+			}
+			method, subPath = fields[0], fields[1]
+		}
+	}
+	if method == "" {
+		return nil
+	}
+	if fd.Recv == nil {
+		return g.errorf(fd.Pos(), "%s must be a method of controller", fd.Name.Name)
+	}
+	params := fd.Type.Params.List
+	if len(params) != 2 || len(params[1].Names) > 1 {
+		return g.errorf(fd.Pos(), "%s must be func(box.Ctx, struct{...})", fd.Name.Name)
+	}
+	ctl := g.controller(fd.Recv.List[0].Type)
+	r := &route{method: method, path: subPath, fn: fd.Name.Name, api: g.print(params[1].Type)}
+	st, err := g.structType(params[1].Type)
+	if err != nil {
+		return err
+	}
+	for _, field := range st.Fields.List {
+		lines, err := g.bindField(field)
+		if err != nil {
+			return err
+		}
+		r.lines = append(r.lines, lines...)
+	}
+	ctl.routes = append(ctl.routes, r)
+	return nil
+}
+
+func (g *generator) controller(recv ast.Expr) *controller {
+	pointer := false
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv, pointer = star.X, true
+	}
+	name := g.print(recv)
+	ctl, ok := g.controllers[name]
+	if !ok {
+		ctl = &controller{name: name}
+		g.controllers[name] = ctl
+	}
+	ctl.pointer = ctl.pointer || pointer
+	return ctl
+}
+
+// structType returns the struct of expr, which is a struct or a type of the package.
+func (g *generator) structType(expr ast.Expr) (*ast.StructType, error) {
+	switch t := expr.(type) {
+	case *ast.StructType:
+		return t, nil
+	case *ast.Ident:
+		if ts, ok := g.types[t.Name]; ok {
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				return st, nil
+			}
+		}
+	}
+	return nil, g.errorf(expr.Pos(), "type %s is not a struct of the package", g.print(expr))
+}
+
+func (g *generator) bindField(field *ast.Field) ([]string, error) {
+	if len(field.Names) == 0 {
+		return g.bindEmbedded(field, g.bindField)
+	}
+	var lines []string
+	for _, ident := range field.Names {
+		fieldLines, err := g.bindNamedField(ident.Name, field)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, fieldLines...)
+	}
+	return lines, nil
+}
+
+func (g *generator) bindNamedField(name string, field *ast.Field) ([]string, error) {
+	switch {
+	case contains(groups, name):
+		st, err := g.structType(field.Type)
+		if err != nil {
+			return nil, err
+		}
+		return g.bindGroup(name, st)
+	case name == "Body":
+		switch g.print(field.Type) {
+		case "io.Reader", "io.ReadCloser":
+			return []string{"api.Body = ctx.Req().Body"}, nil
+		}
+		return []string{
+			"if err := ctx.Bind(&api.Body); err != nil {",
+			`b.Fail(opt.FieldBody, "", err)`,
+			"}",
+		}, nil
+	case name == "SetCookie":
+		return []string{"api.SetCookie = ctx.SetCookie"}, nil
+	case isReturn(name):
+		return g.bindReturn(name, field)
+	}
+	return nil, nil
+}
+
+// bindEmbedded binds the fields of an embedded struct of the package by bind,
+// they are promoted to the embedding struct.
+func (g *generator) bindEmbedded(field *ast.Field, bind func(*ast.Field) ([]string, error)) ([]string, error) {
+	if _, ok := field.Type.(*ast.StarExpr); ok {
+		return nil, g.errorf(field.Pos(), "embedded pointer %s is not supported, use opt.RouteAPI instead", g.print(field.Type))
+	}
+	st, err := g.structType(field.Type)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, f := range st.Fields.List {
+		fieldLines, err := bind(f)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, fieldLines...)
+	}
+	return lines, nil
+}
+
+func (g *generator) bindGroup(group string, st *ast.StructType) ([]string, error) {
+	var lines []string
+	for _, field := range st.Fields.List {
+		tags := parseTags(field.Tag)
+		if _, ok := tags["-"]; ok {
+			continue
+		}
+		if len(field.Names) == 0 {
+			if _, ok := tags["name"]; ok {
+				return nil, g.errorf(field.Pos(), "named embedded struct %s is not supported, use opt.RouteAPI instead", g.print(field.Type))
+			}
+			embedded, err := g.bindEmbedded(field, func(f *ast.Field) ([]string, error) {
+				return g.bindGroup(group, &ast.StructType{Fields: &ast.FieldList{List: []*ast.Field{f}}})
+			})
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, embedded...)
+			continue
+		}
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			if g.isStruct(field.Type) {
+				return nil, g.errorf(field.Pos(), "nested struct field %s is not supported, use opt.RouteAPI instead", ident.Name)
+			}
+			if _, ok := field.Type.(*ast.MapType); ok {
+				return nil, g.errorf(field.Pos(), "map field %s is not supported, use opt.RouteAPI instead", ident.Name)
+			}
+			name := internal.CamelToSnake(ident.Name)
+			if v, ok := tags["name"]; ok {
+				name = v
+			}
+			quoted := strconv.Quote(name)
+			read, pointer, err := g.reader(group, quoted, field.Type)
+			if err != nil {
+				return nil, err
+			}
+			target := "api." + group + "." + ident.Name
+			value := "v"
+			if pointer {
+				value = "&v"
+			}
+			lines = append(lines,
+				fmt.Sprintf("if v, err := %s; err == nil {", read),
+				fmt.Sprintf("%s = %s", target, value),
+				"} else {",
+				fmt.Sprintf("b.Fail(opt.Field%s, %s, err)", group, quoted),
+				"}",
+			)
+		}
+	}
+	return lines, nil
+}
+
+// isStruct reports whether expr is a struct, a struct type of the package or a pointer to them.
+func (g *generator) isStruct(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.StructType:
+		return true
+	case *ast.Ident:
+		if ts, ok := g.types[t.Name]; ok {
+			_, ok := ts.Type.(*ast.StructType)
+			return ok
+		}
+	}
+	return false
+}
+
+// reader returns the expression to read a parameter of type expr,
+// pointer reports whether the value should be referenced.
+func (g *generator) reader(group, name string, expr ast.Expr) (read string, pointer bool, err error) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		read, _, err = g.reader(group, name, star.X)
+		return read, true, err
+	}
+	p := fmt.Sprintf("ctx.%s(%s)", group, name)
+	switch typ := g.print(expr); typ {
+	case "time.Time":
+		return p + ".Time(time.RFC3339)", false, nil
+	case "[]time.Time":
+		return p + ".TimeArray(time.RFC3339)", false, nil
+	case "box.File":
+		return fmt.Sprintf("ctx.FormFile(%s)", name), false, nil
+	case "[]box.File":
+		return fmt.Sprintf("ctx.FormFiles(%s)", name), false, nil
+	case "[]byte", "[]uint8":
+		return p + ".Bytes()", false, nil
+	default:
+		if m, ok := paramMethods[typ]; ok {
+			return p + "." + m + "()", false, nil
+		}
+		if m, ok := paramMethods[strings.TrimPrefix(typ, "[]")]; ok && strings.HasPrefix(typ, "[]") {
+			return p + "." + m + "Array()", false, nil
+		}
+		return "", false, g.errorf(expr.Pos(), "type %s is not supported, use opt.RouteAPI instead", typ)
+	}
+}
+
+func (g *generator) bindReturn(name string, field *ast.Field) ([]string, error) {
+	ft, ok := field.Type.(*ast.FuncType)
+	if !ok {
+		return nil, g.errorf(field.Pos(), "%s must be a func", name)
+	}
+	var args []string
+	for _, p := range ft.Params.List {
+		for i := 0; i < len(p.Names) || i == 0; i++ {
+			args = append(args, g.print(p.Type))
+		}
+	}
+	if len(args) == 0 || len(args) > 2 || len(args) == 2 && args[1] != "box.RespMeta" {
+		return nil, g.errorf(field.Pos(), "%s must be func(T) or func(T, box.RespMeta)", name)
+	}
+	typ := args[0]
+	if typ == "box.Download" {
+		if len(args) > 1 {
+			return nil, g.errorf(field.Pos(), "%s of box.Download can not have box.RespMeta", name)
+		}
+		return []string{fmt.Sprintf("api.%s = func(v %s) {", name, typ), "ctx.ServeDownload(v)", "}"}, nil
+	}
+	code := 200
+	if field.Tag != nil {
+		if status := reflect.StructTag(unquote(field.Tag.Value)).Get("status"); status != "" {
+			var err error
+			code, err = strconv.Atoi(status)
+			if err != nil {
+				return nil, g.errorf(field.Pos(), "invalid status of %s: %v", name, err)
+			}
+		}
+	}
+	if len(args) > 1 {
+		return []string{
+			fmt.Sprintf("api.%s = func(v %s, meta box.RespMeta) {", name, typ),
+			fmt.Sprintf("b.Respond(v, %d, meta)", code),
+			"}",
+		}, nil
+	}
+	lines := []string{fmt.Sprintf("api.%s = func(v %s) {", name, typ)}
+	if code != 200 {
+		lines = append(lines, fmt.Sprintf("ctx.SetStatus(%d)", code))
+	}
+	return append(lines, "ctx.ResponseJSON(v)", "}"), nil
+}
+
+// addImports records the imports of file,
+// which may be used by the types of generated code.
+func (g *generator) addImports(file *ast.File) {
+	for _, spec := range file.Imports {
+		importPath := unquote(spec.Path.Value)
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if _, ok := g.imports[name]; !ok {
+			g.imports[name] = importPath
+		}
+	}
+}
+
+// usedImports returns the imports used by src, the standard packages are sorted first.
+func (g *generator) usedImports(src []byte) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("biugen: parse generated code: %w", err)
+	}
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	var std, others []string
+	for name := range used {
+		importPath, ok := g.imports[name]
+		if !ok {
+			continue
+		}
+		spec := strconv.Quote(importPath)
+		if path.Base(importPath) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			others = append(others, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	if len(std) > 0 && len(others) > 0 {
+		std = append(std, "")
+	}
+	return append(std, others...), nil
+}
+
+func (g *generator) render() ([]byte, error) {
+	var body bytes.Buffer
+	ctlNames := make([]string, 0, len(g.controllers))
+	for name := range g.controllers {
+		ctlNames = append(ctlNames, name)
+	}
+	sort.Strings(ctlNames)
+	for _, name := range ctlNames {
+		ctl := g.controllers[name]
+		typ := ctl.name
+		if ctl.pointer {
+			typ = "*" + typ
+		}
+		fmt.Fprintf(&body, "\n// Register%s registers the routes of %s with static bindings.\n", exported(ctl.name), ctl.name)
+		fmt.Fprintf(&body, "func Register%s(ws biu.WS, ctl %s) {\n", exported(ctl.name), typ)
+		for _, r := range ctl.routes {
+			fmt.Fprintf(&body, "ws.Route(ws.%s(%q), opt.RouteGenerated(ctl.%s, func(ctx box.Ctx, b *opt.Binding) {\n", r.method, r.path, r.fn)
+			fmt.Fprintf(&body, "var api %s\n", r.api)
+			for _, line := range r.lines {
+				body.WriteString(line + "\n")
+			}
+			body.WriteString("if !b.Check(&api) {\nreturn\n}\n")
+			fmt.Fprintf(&body, "ctl.%s(ctx, api)\n}))\n", r.fn)
+		}
+		body.WriteString("}\n")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by biugen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n", g.pkg)
+	imports, err := g.usedImports(append([]byte("package "+g.pkg+"\n"), body.Bytes()...))
+	if err != nil {
+		return nil, err
+	}
+	if len(imports) > 0 {
+		buf.WriteString("\nimport (\n" + strings.Join(imports, "\n") + "\n)\n")
+	}
+	buf.Write(body.Bytes())
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("biugen: format generated code: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) print(node ast.Node) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, g.fset, node)
+	return buf.String()
+}
+
+func (g *generator) errorf(pos token.Pos, format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %s", g.fset.Position(pos), fmt.Sprintf(format, args...))
+}
+
+// parseTags parses the biu tag of a field.
+func parseTags(tag *ast.BasicLit) map[string]string {
+	tags := make(map[string]string)
+	if tag == nil {
+		return tags
+	}
+	cfg, ok := reflect.StructTag(unquote(tag.Value)).Lookup("biu")
+	if !ok {
+		return tags
+	}
+	for _, item := range strings.Split(cfg, ";") {
+		k, v, _ := strings.Cut(item, ":")
+		tags[k] = v
+	}
+	return tags
+}
+
+// isReturn reports whether name is Return or a named return like ReturnCreated.
+func isReturn(name string) bool {
+	rest := strings.TrimPrefix(name, "Return")
+	if rest == name {
+		return false
+	}
+	return rest == "" || unicode.IsUpper([]rune(rest)[0])
+}
+
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func unquote(s string) string {
+	v, err := strconv.Unquote(s)
+	if err != nil {
+		return s
+	}
+	return v
+}
+
+func contains(arr []string, s string) bool {
+	for _, v := range arr {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/cmd/biugen/testdata/user"
+	"github.com/tuotoo/biu/opt"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join("testdata", "user")
+	golden := filepath.Join(dir, "biu_gen.go")
+	src, err := generate(dir, "biu_gen.go")
+	assert.NoError(t, err)
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, src, 0o644))
+	}
+	want, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), string(src))
+}
+
+func TestGeneratedRoutes(t *testing.T) {
+	c := biu.New()
+	c.RouteDefaults(opt.StrictBinding(4001))
+	c.AddServices("", nil, biu.NS{NameSpace: "users", Controller: &user.UserCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.GET("/users/1").Expect().Status(http.StatusOK).JSON().Object().HasValue("code", 0)
+	resp := e.POST("/users").WithJSON(user.User{ID: "1", Name: "a"}).Expect().Status(http.StatusCreated)
+	resp.JSON().Object().Value("data").Object().HasValue("id", "1").HasValue("name", "a")
+	resp.Cookie("created").Value().IsEqual("1")
+	e.PUT("/users/a.txt/avatar").WithText("avatar").Expect().Status(http.StatusOK).
+		Body().IsEqual("avatar")
+	list := e.GET("/users").WithQuery("page", 1).Expect().Status(http.StatusOK)
+	list.Header("X-Total").IsEqual("0")
+	list.JSON().Object().HasValue("data", nil)
+	e.GET("/users").WithQuery("page", "a").Expect().JSON().Object().
+		HasValue("code", 4001).Path("$.data[0].field").IsEqual("page")
+	e.GET("/users").WithQuery("page", 0).Expect().JSON().Object().
+		HasValue("code", http.StatusBadRequest).Path("$.data[0].field").IsEqual("page")
+	e.POST("/users").WithText("{").WithHeader("Content-Type", "application/json").Expect().
+		JSON().Object().HasValue("code", 4001).Path("$.data[0].in").IsEqual("body")
+	e.POST("/users").WithJSON(user.User{Name: "a"}).Expect().JSON().Object().
+		HasValue("code", http.StatusBadRequest).Path("$.data[0].field").IsEqual("id")
+
+	var params []string
+	for _, ws := range c.RegisteredWebServices() {
+		for _, r := range ws.Routes() {
+			if r.Path == "/users/{id}" && r.Method == http.MethodGet {
+				for _, p := range r.ParameterDocs {
+					params = append(params, p.Data().Name)
+				}
+			}
+		}
+	}
+	assert.Contains(t, params, "X-Token")
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	_, err := generate(filepath.Join("testdata", "invalid"), "biu_gen.go")
+	assert.ErrorContains(t, err, "invalid.go:10:3: map field Filter is not supported, use opt.RouteAPI instead")
+}
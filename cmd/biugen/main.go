@@ -0,0 +1,62 @@
+// Command biugen generates static bindings of RouteAPI functions.
+//
+// Methods of controllers marked by a biu:route directive are bound
+// without reflection, and a Register function is generated for each controller:
+//
+//	//go:generate go run github.com/tuotoo/biu/cmd/biugen
+//
+//	//biu:route GET /{id}
+//	func (ctl UserCtl) Get(ctx box.Ctx, api struct {
+//		Path struct {
+//			ID string
+//		}
+//		Return func(User)
+//	}) {
+//		api.Return(ctl.users[api.Path.ID])
+//	}
+//
+//	func (ctl UserCtl) WebService(ws biu.WS) {
+//		RegisterUserCtl(ws, ctl)
+//	}
+//
+// The generated routes are documented and checked the same as RouteAPI,
+// the defaults, constraints and validate tags are applied by opt.Binding,
+// and conversion failures are responded with opt.StrictBinding,
+// including the options set by RouteDefaults.
+//
+// The generated bindings support a subset of the shapes of RouteAPI:
+//
+//   - the request struct and its groups are struct literals or struct types of the package,
+//     which may embed struct types of the package, but not pointers to them;
+//   - parameters of Header, Cookie, Path, Query and Form are the basic types, time.Time,
+//     box.File, slices of them and pointers to them;
+//   - Body is decoded by ctx.Bind, or is the request body as io.Reader or io.ReadCloser;
+//   - returns are func(T) or func(T, box.RespMeta), and func(box.Download).
+//
+// Nested structs, maps and encoding.TextUnmarshaler types of parameters are not supported,
+// biugen fails on them and the route should be registered with opt.RouteAPI instead.
+//
+// Only the server side is generated, typed clients of the routes are out of scope,
+// they may be generated from the OpenAPI document instead.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the controller package")
+	output := flag.String("o", "biu_gen.go", "name of the generated file")
+	flag.Parse()
+
+	src, err := generate(*dir, *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,13 @@
+package invalid
+
+import "github.com/tuotoo/biu/box"
+
+type Ctl struct{}
+
+//biu:route GET /
+func (ctl Ctl) List(ctx box.Ctx, api struct {
+	Query struct {
+		Filter map[string]string
+	}
+}) {
+}
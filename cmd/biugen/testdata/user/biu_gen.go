@@ -0,0 +1,116 @@
+// Code generated by biugen. DO NOT EDIT.
+
+package user
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+// RegisterUserCtl registers the routes of UserCtl with static bindings.
+func RegisterUserCtl(ws biu.WS, ctl *UserCtl) {
+	ws.Route(ws.GET("/"), opt.RouteGenerated(ctl.List, func(ctx box.Ctx, b *opt.Binding) {
+		var api ListReq
+		if v, err := ctx.Query("page").Int(); err == nil {
+			api.Query.Page = &v
+		} else {
+			b.Fail(opt.FieldQuery, "page", err)
+		}
+		if v, err := ctx.Query("name").String(); err == nil {
+			api.Query.Name = v
+		} else {
+			b.Fail(opt.FieldQuery, "name", err)
+		}
+		if v, err := ctx.Query("tags").StringArray(); err == nil {
+			api.Query.Tags = v
+		} else {
+			b.Fail(opt.FieldQuery, "tags", err)
+		}
+		if v, err := ctx.Query("since").Time(time.RFC3339); err == nil {
+			api.Query.Since = v
+		} else {
+			b.Fail(opt.FieldQuery, "since", err)
+		}
+		api.Return = func(v []User, meta box.RespMeta) {
+			b.Respond(v, 200, meta)
+		}
+		if !b.Check(&api) {
+			return
+		}
+		ctl.List(ctx, api)
+	}))
+	ws.Route(ws.GET("/{id}"), opt.RouteGenerated(ctl.Get, func(ctx box.Ctx, b *opt.Binding) {
+		var api struct {
+			Header struct {
+				Token string `biu:"name:X-Token"`
+			}
+			Path struct {
+				ID string
+			}
+			Return func(User)
+		}
+		if v, err := ctx.Header("X-Token").String(); err == nil {
+			api.Header.Token = v
+		} else {
+			b.Fail(opt.FieldHeader, "X-Token", err)
+		}
+		if v, err := ctx.Path("id").String(); err == nil {
+			api.Path.ID = v
+		} else {
+			b.Fail(opt.FieldPath, "id", err)
+		}
+		api.Return = func(v User) {
+			ctx.ResponseJSON(v)
+		}
+		if !b.Check(&api) {
+			return
+		}
+		ctl.Get(ctx, api)
+	}))
+	ws.Route(ws.POST("/"), opt.RouteGenerated(ctl.Create, func(ctx box.Ctx, b *opt.Binding) {
+		var api struct {
+			Body      User
+			SetCookie func(*http.Cookie)
+			Return    func(User) `status:"201"`
+		}
+		if err := ctx.Bind(&api.Body); err != nil {
+			b.Fail(opt.FieldBody, "", err)
+		}
+		api.SetCookie = ctx.SetCookie
+		api.Return = func(v User) {
+			ctx.SetStatus(201)
+			ctx.ResponseJSON(v)
+		}
+		if !b.Check(&api) {
+			return
+		}
+		ctl.Create(ctx, api)
+	}))
+	ws.Route(ws.PUT("/{id}/avatar"), opt.RouteGenerated(ctl.Avatar, func(ctx box.Ctx, b *opt.Binding) {
+		var api struct {
+			Path struct {
+				ID string
+			}
+			Body   io.Reader
+			Return func(box.Download)
+		}
+		if v, err := ctx.Path("id").String(); err == nil {
+			api.Path.ID = v
+		} else {
+			b.Fail(opt.FieldPath, "id", err)
+		}
+		api.Body = ctx.Req().Body
+		api.Return = func(v box.Download) {
+			ctx.ServeDownload(v)
+		}
+		if !b.Check(&api) {
+			return
+		}
+		ctl.Avatar(ctx, api)
+	}))
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+)
+
+type User struct {
+	ID      string    `json:"id" validate:"required"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+}
+
+type UserCtl struct {
+	users map[string]User
+}
+
+func (ctl *UserCtl) WebService(ws biu.WS) {
+	RegisterUserCtl(ws, ctl)
+}
+
+type Paging struct {
+	Page *int `biu:"min:1;default:1"`
+}
+
+type ListQuery struct {
+	Query struct {
+		Paging
+		Name  string
+		Tags  []string
+		Since time.Time
+	}
+}
+
+type ListReq struct {
+	ListQuery
+	Return func([]User, box.RespMeta)
+}
+
+//biu:route GET /
+func (ctl UserCtl) List(ctx box.Ctx, api ListReq) {
+	var users []User
+	for _, u := range ctl.users {
+		users = append(users, u)
+	}
+	api.Return(users, box.RespMeta{Header: http.Header{"X-Total": {strconv.Itoa(len(users))}}})
+}
+
+//biu:route GET /{id}
+func (ctl UserCtl) Get(ctx box.Ctx, api struct {
+	Header struct {
+		Token string `biu:"name:X-Token"`
+	}
+	Path struct {
+		ID string
+	}
+	Return func(User)
+}) {
+	api.Return(ctl.users[api.Path.ID])
+}
+
+//biu:route POST /
+func (ctl UserCtl) Create(ctx box.Ctx, api struct {
+	Body      User
+	SetCookie func(*http.Cookie)
+	Return    func(User) `status:"201"`
+}) {
+	api.SetCookie(&http.Cookie{Name: "created", Value: api.Body.ID})
+	api.Return(api.Body)
+}
+
+//biu:route PUT /{id}/avatar
+func (ctl *UserCtl) Avatar(ctx box.Ctx, api struct {
+	Path struct {
+		ID string
+	}
+	Body   io.Reader
+	Return func(box.Download)
+}) {
+	bs, _ := io.ReadAll(api.Body)
+	api.Return(box.Download{Name: api.Path.ID, Content: bytes.NewReader(bs)})
+}
+
+// Delete is not generated without the directive.
+func (ctl UserCtl) Delete(ctx box.Ctx) {}
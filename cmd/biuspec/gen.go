@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+)
+
+// options are the options of the program writing the document.
+type options struct {
+	Pkg     string
+	Func    string
+	Info    string
+	OpenAPI bool
+	YAML    bool
+	// Docs is the renderer of docs writing the reference instead,
+	// e.g. Markdown or HTML, which uses the OpenAPI document.
+	Docs   string
+	Output string
+}
+
+var programTmpl = template.Must(template.New("program").Parse(`// Code generated by biuspec. DO NOT EDIT.
+
+package main
+
+import (
+	{{- if .Docs}}
+	"bytes"
+	{{- else if not .YAML}}
+	"encoding/json"
+	{{- end}}
+	"log"
+	"os"
+
+	"github.com/tuotoo/biu"
+	{{- if .Docs}}
+	"github.com/tuotoo/biu/docs"
+	{{- else if .YAML}}
+	"github.com/tuotoo/biu/openapi"
+	{{- end}}
+
+	{{if or .Func .Info}}target{{else}}_{{end}} {{printf "%q" .Pkg}}
+)
+
+func main() {
+	{{- if .Func}}
+	c := biu.New()
+	target.{{.Func}}(c)
+	{{- else}}
+	c := biu.DefaultContainer
+	{{- end}}
+	doc, err := c.{{if or .OpenAPI .Docs}}BuildOpenAPI{{else}}BuildSwagger{{end}}({{if .Info}}target.{{.Info}}{{else}}biu.SwaggerInfo{}{{end}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	{{- if .Docs}}
+	var buf bytes.Buffer
+	err = docs.{{.Docs}}(&buf, doc)
+	bs := buf.Bytes()
+	{{- else if .YAML}}
+	bs, err := openapi.YAML(doc)
+	{{- else}}
+	bs, err := json.MarshalIndent(doc, "", "  ")
+	bs = append(bs, '\n')
+	{{- end}}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile({{printf "%q" .Output}}, bs, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+`))
+
+// program returns the source of the program writing the document.
+func program(opts options) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := programTmpl.Execute(&buf, opts); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// run runs the program in a temporary directory of dir,
+// which must be in the module of the registering package.
+func run(dir string, src []byte) error {
+	tmp, err := os.MkdirTemp(dir, "biuspec_")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	if err := os.WriteFile(filepath.Join(tmp, "main.go"), src, 0o644); err != nil {
+		return err
+	}
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = tmp
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run program: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const testPkg = "github.com/tuotoo/biu/cmd/biuspec/testdata/api"
+
+func TestProgram(t *testing.T) {
+	src, err := program(options{Pkg: testPkg, Func: "Register", Output: "swagger.json"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "target.Register(c)")
+	assert.Contains(t, string(src), "c.BuildSwagger(biu.SwaggerInfo{})")
+	assert.Contains(t, string(src), `json.MarshalIndent(doc, "", "  ")`)
+
+	src, err = program(options{Pkg: testPkg, Info: "Info", OpenAPI: true, YAML: true, Output: "openapi.yaml"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "c := biu.DefaultContainer")
+	assert.Contains(t, string(src), "c.BuildOpenAPI(target.Info)")
+	assert.Contains(t, string(src), "openapi.YAML(doc)")
+	assert.NotContains(t, string(src), "encoding/json")
+
+	src, err = program(options{Pkg: testPkg, Output: "swagger.json"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), `_ "`+testPkg+`"`)
+
+	src, err = program(options{Pkg: testPkg, Func: "Register", Docs: "Markdown", Output: "api.md"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "c.BuildOpenAPI(biu.SwaggerInfo{})")
+	assert.Contains(t, string(src), "docs.Markdown(&buf, doc)")
+	assert.NotContains(t, string(src), "encoding/json")
+}
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the program with go run")
+	}
+	dir := t.TempDir()
+
+	output := filepath.Join(dir, "swagger.json")
+	src, err := program(options{Pkg: testPkg, Func: "Register", Info: "Info", Output: output})
+	assert.NoError(t, err)
+	assert.NoError(t, run(".", src))
+	var swo map[string]interface{}
+	bs, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(bs, &swo))
+	assert.Equal(t, "2.0", swo["swagger"])
+	assert.Equal(t, "users", swo["info"].(map[string]interface{})["title"])
+	assert.Contains(t, swo["paths"], "/v1/users/{id}")
+
+	output = filepath.Join(dir, "openapi.yaml")
+	src, err = program(options{Pkg: testPkg, Func: "Register", OpenAPI: true, YAML: true, Output: output})
+	assert.NoError(t, err)
+	assert.NoError(t, run(".", src))
+	var doc map[string]interface{}
+	bs, err = os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.NoError(t, yaml.Unmarshal(bs, &doc))
+	assert.Equal(t, "3.1.0", doc["openapi"])
+	assert.Contains(t, doc["paths"], "/v1/users/{id}")
+
+	output = filepath.Join(dir, "api.md")
+	src, err = program(options{Pkg: testPkg, Func: "Register", Info: "Info", Docs: "Markdown", Output: output})
+	assert.NoError(t, err)
+	assert.NoError(t, run(".", src))
+	bs, err = os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Contains(t, string(bs), "# users 1.0.0\n")
+	assert.Contains(t, string(bs), "### GET /v1/users/{id}\n")
+
+	entries, err := os.ReadDir(".")
+	assert.NoError(t, err)
+	for _, e := range entries {
+		assert.NotRegexp(t, "^biuspec_", e.Name())
+	}
+}
@@ -0,0 +1,64 @@
+// Command biuspec writes the API document of a package without starting the server.
+//
+// The package registers its routes in a function of func(*biu.Container),
+// which is called with a new container to build the document:
+//
+//	//go:generate go run github.com/tuotoo/biu/cmd/biuspec -pkg example.com/app/api -o swagger.yaml
+//
+//	func Register(c *biu.Container) {
+//		c.AddServices("/v1", nil, biu.NS{NameSpace: "users", Controller: UserCtl{}})
+//	}
+//
+// If -func is empty, the routes of the default container registered in init are used.
+// The document is the OpenAPI 3.1 one with -openapi, or swagger 2.0 by default,
+// it is written in YAML if the output ends with .yaml or .yml, and in JSON otherwise.
+// If the output ends with .md or .html, the reference of the OpenAPI document
+// rendered by package docs is written instead, which includes the error codes.
+// biuspec must be run in the module of the package.
+package main
+
+import (
+	"flag"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	pkg := flag.String("pkg", "", "import path of the package registering routes")
+	fn := flag.String("func", "Register", "name of the func(*biu.Container) registering routes")
+	info := flag.String("info", "", "name of the biu.SwaggerInfo variable of the document")
+	openAPI := flag.Bool("openapi", false, "write the OpenAPI 3.1 document instead of swagger 2.0")
+	output := flag.String("o", "swagger.json", "name of the output file")
+	flag.Parse()
+
+	if *pkg == "" {
+		log.Fatal("-pkg is required")
+	}
+	out, err := filepath.Abs(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ext := strings.ToLower(filepath.Ext(out))
+	opts := options{
+		Pkg:     *pkg,
+		Func:    *fn,
+		Info:    *info,
+		OpenAPI: *openAPI,
+		YAML:    ext == ".yaml" || ext == ".yml",
+		Output:  out,
+	}
+	switch ext {
+	case ".md":
+		opts.Docs = "Markdown"
+	case ".html", ".htm":
+		opts.Docs = "HTML"
+	}
+	src, err := program(opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := run(".", src); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,36 @@
+// Package api registers the routes of biuspec tests.
+package api
+
+import (
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+// Info is the information of the document.
+var Info = biu.SwaggerInfo{Title: "users", Version: "1.0.0"}
+
+// User is a user.
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserCtl is the controller of users.
+type UserCtl struct{}
+
+// WebService implements biu.CtlInterface.
+func (ctl UserCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			ID string
+		}
+		Return func(User)
+	}) {
+	}))
+}
+
+// Register registers the routes of users.
+func Register(c *biu.Container) {
+	c.AddServices("/v1", nil, biu.NS{NameSpace: "users", Controller: UserCtl{}})
+}
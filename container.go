@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 
 	"github.com/emicklei/go-restful/v3"
 	"github.com/go-openapi/spec"
+	"github.com/gorilla/websocket"
 
 	"github.com/tuotoo/biu/box"
 	"github.com/tuotoo/biu/log"
@@ -27,14 +29,27 @@ func init() {
 type Container struct {
 	*restful.Container
 	*http.Server
-	swaggerTags map[*http.ServeMux][]spec.Tag
+	swaggerTags []spec.Tag
 	errors      map[int]string
 	routeID     map[string]string
 	logger      log.ILogger
+	socketsMu   sync.Mutex
+	sockets     map[*websocket.Conn]struct{}
+
+	deprecatedMu sync.Mutex
+	deprecated   []*deprecatedRoute
+
+	routeDefaults []opt.RouteFunc
+	// envelope reports whether the responses are wrapped in box.CommonResp
+	// by DefaultResponseTransformer and DefaultErrorTransformer.
+	envelope bool
 }
 
 func DefaultResponseTransformer(ctx box.Ctx) {
 	ctx.Next()
+	if ctx.IsRawResponse() {
+		return
+	}
 
 	code, ok := ctx.Attribute(box.BiuAttrErrCode).(int)
 	if ok && code != 0 {
@@ -49,10 +64,22 @@ func DefaultResponseTransformer(ctx box.Ctx) {
 		return
 	}
 
-	err := ctx.WriteAsJson(box.CommonResp{
+	status, ok := ctx.Attribute(box.BiuAttrStatus).(int)
+	if !ok || status == 0 {
+		status = http.StatusOK
+	}
+	if !bodyAllowed(status) {
+		ctx.Response.WriteHeader(status)
+		return
+	}
+	var body interface{} = box.CommonResp{
 		Data:    entities[0],
 		RouteID: ctx.RouteID(),
-	})
+	}
+	if noEnvelope, _ := ctx.Attribute(box.BiuAttrNoEnvelope).(bool); noEnvelope {
+		body = entities[0]
+	}
+	err := ctx.WriteHeaderAndJson(status, body, restful.MIME_JSON)
 	if err != nil {
 		ctx.Logger.Info(log.BiuInternalInfo{
 			Err: err,
@@ -64,6 +91,11 @@ func DefaultResponseTransformer(ctx box.Ctx) {
 	}
 }
 
+// bodyAllowed reports whether a response with status can have a body.
+func bodyAllowed(status int) bool {
+	return status >= 200 && status != http.StatusNoContent && status != http.StatusNotModified
+}
+
 func DefaultErrorTransformer(c *Container) func(ctx box.Ctx) {
 	return func(ctx box.Ctx) {
 		routeID := c.RouteIDMap()[ctx.RouteSignature()]
@@ -93,9 +125,13 @@ func DefaultErrorTransformer(c *Container) func(ctx box.Ctx) {
 			logInfo.Err = err
 		}
 		ctx.Logger.Info(logInfo)
+		if ctx.IsRawResponse() {
+			return
+		}
 		err := ctx.WriteAsJson(box.CommonResp{
 			Code:    code,
 			Message: msg,
+			Data:    ctx.Attribute(box.BiuAttrErrData),
 			RouteID: ctx.RouteID(),
 		})
 		if err != nil {
@@ -116,6 +152,7 @@ func New(container ...*restful.Container) *Container {
 	c := NewContainer(container...)
 	c.Filter(c.FilterFunc(DefaultResponseTransformer))
 	c.Filter(c.FilterFunc(DefaultErrorTransformer(c)))
+	c.envelope = true
 	return c
 }
 
@@ -130,11 +167,11 @@ func NewContainer(container ...*restful.Container) *Container {
 	errors := make(map[int]string)
 	routeMap := make(map[string]string)
 	c := &Container{
-		Container:   rc,
-		swaggerTags: make(map[*http.ServeMux][]spec.Tag),
-		routeID:     routeMap,
-		errors:      errors,
-		logger:      log.DefaultLogger{},
+		Container: rc,
+		routeID:   routeMap,
+		errors:    errors,
+		logger:    log.DefaultLogger{},
+		sockets:   make(map[*websocket.Conn]struct{}),
 	}
 	return c
 }
@@ -149,6 +186,19 @@ func AddServices(prefix string, opts opt.ServicesFuncArr, wss ...NS) {
 	DefaultContainer.AddServices(prefix, opts, wss...)
 }
 
+// RouteDefaults sets the options applied to every route added after it,
+// before the options of the route itself.
+//
+//	c.RouteDefaults(opt.StrictBinding(400))
+func (c *Container) RouteDefaults(opts ...opt.RouteFunc) {
+	c.routeDefaults = append(c.routeDefaults, opts...)
+}
+
+// RouteDefaults sets the default options of routes for default container.
+func RouteDefaults(opts ...opt.RouteFunc) {
+	DefaultContainer.RouteDefaults(opts...)
+}
+
 // Run starts up a web server for container.
 func (c *Container) Run(addr string, opts ...opt.RunFunc) {
 	run(addr, c, opts...)
@@ -162,7 +212,8 @@ func Run(addr string, opts ...opt.RunFunc) {
 // NewTestServer returns a Test Server.
 func (c *Container) NewTestServer() *TestServer {
 	return &TestServer{
-		Server: httptest.NewServer(c),
+		Server:    httptest.NewServer(c),
+		container: c,
 	}
 }
 
@@ -23,6 +23,7 @@ func HandleWithLogger(f func(ctx box.Ctx), logger log.ILogger) restful.RouteFunc
 			Response: response,
 			Logger:   logger,
 		}
+		defer c.Finish()
 		e := errc.Catch(new(error))
 		defer e.Handle()
 		c.ErrCatcher = e
@@ -0,0 +1,95 @@
+package biu
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+// DeprecatedRoute is a route deprecated by opt.Deprecated or opt.DeprecatedAt and its calls.
+type DeprecatedRoute struct {
+	Method string
+	Path   string
+	// Date is the time the route is deprecated, zero if it is not set by opt.DeprecatedAt.
+	Date        time.Time
+	Sunset      time.Time
+	Replacement string
+	// Calls is the number of requests since the route is added,
+	// LastCalled is the time of the last one.
+	Calls      int64
+	LastCalled time.Time
+}
+
+// deprecatedRoute counts the calls of a deprecated route.
+type deprecatedRoute struct {
+	DeprecatedRoute
+	calls      atomic.Int64
+	lastCalled atomic.Int64
+}
+
+// DeprecatedCalls lists the deprecated routes which are still called by clients,
+// the most called ones come first.
+func (c *Container) DeprecatedCalls() []DeprecatedRoute {
+	c.deprecatedMu.Lock()
+	defer c.deprecatedMu.Unlock()
+	var rst []DeprecatedRoute
+	for _, r := range c.deprecated {
+		calls := r.calls.Load()
+		if calls == 0 {
+			continue
+		}
+		v := r.DeprecatedRoute
+		v.Calls = calls
+		v.LastCalled = time.Unix(0, r.lastCalled.Load())
+		rst = append(rst, v)
+	}
+	sort.SliceStable(rst, func(i, j int) bool {
+		return rst[i].Calls > rst[j].Calls
+	})
+	return rst
+}
+
+// DeprecatedCalls lists the deprecated routes of default container which are still called.
+func DeprecatedCalls() []DeprecatedRoute {
+	return DefaultContainer.DeprecatedCalls()
+}
+
+// deprecationFilter counts the calls of a deprecated route,
+// and responds the Deprecation header of RFC 9745 if the date is set by opt.DeprecatedAt,
+// the Sunset and Link headers.
+func (c *Container) deprecationFilter(method, path string, cfg *opt.Route) func(ctx box.Ctx) {
+	var deprecation string
+	if !cfg.DeprecatedAt.IsZero() {
+		deprecation = "@" + strconv.FormatInt(cfg.DeprecatedAt.Unix(), 10)
+	}
+	route := &deprecatedRoute{DeprecatedRoute: DeprecatedRoute{
+		Method:      method,
+		Path:        path,
+		Date:        cfg.DeprecatedAt,
+		Sunset:      cfg.Sunset,
+		Replacement: cfg.Replacement,
+	}}
+	c.deprecatedMu.Lock()
+	c.deprecated = append(c.deprecated, route)
+	c.deprecatedMu.Unlock()
+	return func(ctx box.Ctx) {
+		route.calls.Add(1)
+		route.lastCalled.Store(time.Now().UnixNano())
+		header := ctx.Resp().Header()
+		if deprecation != "" {
+			header.Set("Deprecation", deprecation)
+		}
+		if !cfg.Sunset.IsZero() {
+			header.Set("Sunset", cfg.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if cfg.Replacement != "" {
+			header.Add("Link", "<"+cfg.Replacement+`>; rel="successor-version"`)
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,81 @@
+package biu_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+var (
+	deprecatedAt = time.Date(2029, 1, 2, 0, 0, 0, 0, time.UTC)
+	sunset       = time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+)
+
+type internalResp struct {
+	Secret string `json:"secret"`
+}
+
+type versionCtl struct{}
+
+func (ctl versionCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/old"), opt.Deprecated(sunset, "/version/new"), opt.DeprecatedAt(deprecatedAt), opt.RouteTo(func(ctx box.Ctx) {
+		ctx.ResponseJSON("old")
+	}))
+	ws.Route(ws.GET("/legacy"), opt.Deprecated(time.Time{}, ""))
+	ws.Route(ws.GET("/new"), opt.Since("v2"))
+	ws.Route(ws.GET("/internal"), opt.Hidden(), opt.RouteTo(func(ctx box.Ctx) {
+		ctx.ResponseJSON("internal")
+	}))
+	ws.Route(ws.POST("/new"), opt.Hidden(), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(internalResp)
+	}) {
+	}))
+}
+
+func TestDeprecated(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "version", Controller: versionCtl{}})
+	doc := swaggerJSON(t, c)
+	paths := doc.Value("paths").Object()
+	paths.Keys().ContainsOnly("/version/old", "/version/legacy", "/version/new")
+	paths.Value("/version/new").Object().Keys().ContainsOnly("get")
+	paths.Value("/version/new").Path("$.get").Object().Value("x-since").IsEqual("v2")
+	old := paths.Value("/version/old").Path("$.get").Object()
+	old.Value("deprecated").IsEqual(true)
+	old.Value("x-sunset").IsEqual("2030-01-02")
+	old.Value("x-replacement").IsEqual("/version/new")
+	paths.Value("/version/legacy").Path("$.get").Object().NotContainsKey("x-sunset")
+	doc.NotContainsKey("definitions")
+
+	e := httpexpect.Default(t, swaggerURL(t, c))
+	e.GET("/version/internal").Expect().Status(http.StatusOK).JSON().Path("$.data").IsEqual("internal")
+	resp := e.GET("/version/old").Expect().Status(http.StatusOK)
+	resp.Header("Deprecation").IsEqual("@1862006400")
+	resp.Header("Sunset").IsEqual("Wed, 02 Jan 2030 00:00:00 GMT")
+	resp.Header("Link").IsEqual(`</version/new>; rel="successor-version"`)
+	e.GET("/version/old").Expect().Status(http.StatusOK)
+	legacy := e.GET("/version/legacy").Expect()
+	legacy.Headers().NotContainsKey("Deprecation")
+	legacy.Headers().NotContainsKey("Sunset")
+	e.GET("/version/new").Expect().Headers().NotContainsKey("Deprecation")
+
+	calls := c.DeprecatedCalls()
+	if assert.Len(t, calls, 2) {
+		assert.Equal(t, "/version/old", calls[0].Path)
+		assert.Equal(t, http.MethodGet, calls[0].Method)
+		assert.Equal(t, int64(2), calls[0].Calls)
+		assert.Equal(t, deprecatedAt, calls[0].Date)
+		assert.Equal(t, sunset, calls[0].Sunset)
+		assert.Equal(t, "/version/new", calls[0].Replacement)
+		assert.WithinDuration(t, time.Now(), calls[0].LastCalled, time.Minute)
+		assert.Equal(t, "/version/legacy", calls[1].Path)
+		assert.Equal(t, int64(1), calls[1].Calls)
+		assert.True(t, calls[1].Date.IsZero())
+	}
+}
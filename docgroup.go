@@ -0,0 +1,163 @@
+package biu
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/go-openapi/spec"
+)
+
+const definitionPrefix = "#/definitions/"
+
+// contains reports whether route is selected by the group.
+func (g DocGroup) contains(route restful.Route) bool {
+	if ns, ok := route.Metadata[metaNamespace].(string); ok && slices.Contains(g.NameSpaces, ns) {
+		return true
+	}
+	tags, _ := route.Metadata[restfulspec.KeyOpenAPITags].([]string)
+	for _, tag := range tags {
+		if slices.Contains(g.Tags, tag) {
+			return true
+		}
+	}
+	return g.Match != nil && g.Match(route)
+}
+
+// processGroups removes the operation of a route which is in none of groups,
+// it reports whether the operation is removed.
+func processGroups(swo *spec.Swagger, route restful.Route, groups []DocGroup) bool {
+	if len(groups) == 0 {
+		return false
+	}
+	for _, g := range groups {
+		if g.contains(route) {
+			return false
+		}
+	}
+	removeOperation(swo, route)
+	return true
+}
+
+// pruneTags removes the tags which are not used by operations.
+func pruneTags(swo *spec.Swagger) {
+	used := make(map[string]bool)
+	if swo.Paths != nil {
+		for _, item := range swo.Paths.Paths {
+			for _, op := range []*spec.Operation{
+				item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch,
+			} {
+				if op == nil {
+					continue
+				}
+				for _, tag := range op.Tags {
+					used[tag] = true
+				}
+			}
+		}
+	}
+	tags := swo.Tags[:0]
+	for _, tag := range swo.Tags {
+		if used[tag.Name] {
+			tags = append(tags, tag)
+		}
+	}
+	swo.Tags = tags
+}
+
+// pruneDefinitions removes the definitions which are not referenced by the paths,
+// so hidden routes and other groups do not expose their types.
+func pruneDefinitions(swo *spec.Swagger) {
+	used := make(map[string]bool)
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok && strings.HasPrefix(ref, definitionPrefix) {
+				name, err := url.PathUnescape(strings.TrimPrefix(ref, definitionPrefix))
+				if err == nil && !used[name] {
+					used[name] = true
+					if def, ok := swo.Definitions[name]; ok {
+						walk(jsonValue(def))
+					}
+				}
+			}
+			for _, e := range v {
+				walk(e)
+			}
+		case []interface{}:
+			for _, e := range v {
+				walk(e)
+			}
+		}
+	}
+	walk(jsonValue(swo.Paths))
+	for name := range swo.Definitions {
+		if !used[name] {
+			delete(swo.Definitions, name)
+		}
+	}
+}
+
+// jsonValue returns v decoded from its JSON, nil if it can not be encoded.
+func jsonValue(v interface{}) interface{} {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var rst interface{}
+	_ = json.Unmarshal(bs, &rst)
+	return rst
+}
+
+// checkGroups reports the doc groups whose paths are empty, contain a slash or are duplicated.
+func checkGroups(groups []DocGroup) []error {
+	var errs []error
+	paths := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		p := g.path()
+		if p == "" || strings.Contains(p, "/") {
+			errs = append(errs, fmt.Errorf("invalid path %q of doc group %q", p, g.Name))
+			continue
+		}
+		if paths[p] {
+			errs = append(errs, fmt.Errorf("duplicated path %q of doc groups", p))
+		}
+		paths[p] = true
+	}
+	return errs
+}
+
+// path is the path of the group document, which defaults to the name.
+func (g DocGroup) path() string {
+	if g.Path == "" {
+		return g.Name
+	}
+	return g.Path
+}
+
+// groupDocs builds the documents of info.Groups by build keyed by their paths,
+// and lists them in info.Specs if it is empty.
+// The groups are checked by checkGroups before.
+func groupDocs(info *SwaggerInfo, build func(SwaggerInfo) interface{}) map[string]interface{} {
+	if len(info.Groups) == 0 {
+		return nil
+	}
+	docs := make(map[string]interface{}, len(info.Groups))
+	var specs []DocSpec
+	for _, g := range info.Groups {
+		group := *info
+		group.Groups = []DocGroup{g}
+		group.Specs = nil
+		docs[g.path()] = build(group)
+		specs = append(specs, DocSpec{Name: g.Name, URL: "./" + url.PathEscape(g.path()) + ".json"})
+	}
+	if len(info.Specs) == 0 {
+		info.Specs = specs
+	}
+	return docs
+}
@@ -0,0 +1,132 @@
+package docs_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuotoo/biu/docs"
+	"github.com/tuotoo/biu/openapi"
+)
+
+const testDoc = `
+openapi: 3.1.0
+info:
+  title: users
+  version: 1.0.0
+x-codes:
+  10: unknown
+  2: forbidden
+tags:
+  - name: users
+    description: manage users
+paths:
+  /users/{id}:
+    get:
+      tags: [users]
+      summary: get a user
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+            format: int64
+        - name: fields
+          in: query
+          description: "fields | to return"
+          schema:
+            type: array
+            items:
+              type: string
+              enum: [name, age]
+      responses:
+        "200":
+          description: the user
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/box.CommonResp%5Bapi.User%5D"
+      x-codes:
+        1001: user not found
+    delete:
+      tags: [users]
+      deprecated: true
+      x-sunset: "2030-01-02"
+      x-replacement: /v2/users/{id}
+      responses:
+        "200":
+          description: ok
+  /ping:
+    post:
+      x-since: v2
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: array
+              items:
+                $ref: "#/components/schemas/api.User"
+components:
+  schemas:
+    api.User:
+      required: [id]
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+          description: "full\nname"
+    box.CommonResp[api.User]:
+      properties:
+        code:
+          type: integer
+        data:
+          $ref: "#/components/schemas/api.User"
+`
+
+func parse(t *testing.T) *openapi.Document {
+	doc, err := openapi.Parse([]byte(testDoc))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, docs.Markdown(&buf, parse(t)))
+	md := buf.String()
+	assert.Contains(t, md, "# users 1.0.0\n")
+	assert.Contains(t, md, "## Error codes\n\n| Code | Message |\n| --- | --- |\n| 2 | forbidden |\n| 10 | unknown |\n")
+	assert.Contains(t, md, "## users\n\nmanage users\n")
+	assert.Contains(t, md, "### GET /users/{id}\n\nget a user\n")
+	assert.Contains(t, md, "| id | path | `integer(int64)` | yes |  |\n")
+	assert.Contains(t, md, "| fields | query | `[]string` | no | fields \\| to return One of: name, age. |\n")
+	assert.Contains(t, md, "| 200 | [`api.User`](#schema-api-user) | the user |\n")
+	assert.Contains(t, md, "| 1001 | user not found |\n")
+	assert.Contains(t, md, "> **Deprecated, will be removed after 2030-01-02, use /v2/users/{id} instead.**\n")
+	assert.Contains(t, md, "## default\n\n<a id=\"op-post-ping\"></a>\n\n### POST /ping\n\nSince v2.\n")
+	assert.Contains(t, md, "**Request body** (application/json)")
+	assert.Contains(t, md, "| [`[]api.User`](#schema-api-user) | yes |  |\n")
+	assert.Contains(t, md, "<a id=\"schema-api-user\"></a>\n\n### api.User\n")
+	assert.Contains(t, md, "| name | `string` | no | full<br>name |\n")
+	assert.NotContains(t, md, "### box.CommonResp")
+	assert.Contains(t, md, "Successful responses are wrapped in")
+}
+
+func TestHTML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, docs.HTML(&buf, parse(t)))
+	page := buf.String()
+	assert.Contains(t, page, "<title>users</title>")
+	assert.Contains(t, page, `<a href="#op-get-users-id"><span class="method">GET</span> /users/{id}</a>`)
+	assert.Contains(t, page, `<a href="#op-delete-users-id" class="deprecated">`)
+	assert.Contains(t, page, `<section class="operation" id="op-get-users-id">`)
+	assert.Contains(t, page, `<td><a href="#schema-api-user"><code>api.User</code></a></td><td>the user</td>`)
+	assert.Contains(t, page, "<tr><td>1001</td><td>user not found</td></tr>")
+	assert.Contains(t, page, `<td>fields | to return One of: name, age.</td>`)
+	assert.Contains(t, page, `<section id="schema-api-user">`)
+	assert.NotContains(t, page, "box.CommonResp")
+}
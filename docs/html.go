@@ -0,0 +1,22 @@
+package docs
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+//go:embed reference.html
+var referenceHTML string
+
+var htmlTmpl = template.Must(template.New("reference").
+	Funcs(template.FuncMap{"anchor": anchor}).
+	Parse(referenceHTML))
+
+// HTML writes the reference of doc as a self-contained HTML page,
+// which follows the color scheme of the browser.
+func HTML(w io.Writer, doc *openapi.Document) error {
+	return htmlTmpl.Execute(w, newReference(doc))
+}
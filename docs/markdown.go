@@ -0,0 +1,139 @@
+package docs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+// Markdown writes the reference of doc in Markdown,
+// the operations are grouped by tags and followed by the schemas.
+func Markdown(w io.Writer, doc *openapi.Document) error {
+	ref := newReference(doc)
+	b := bufio.NewWriter(w)
+	title := ref.Title
+	if ref.Version != "" {
+		title += " " + ref.Version
+	}
+	fmt.Fprintf(b, "# %s\n", title)
+	if ref.Description != "" {
+		fmt.Fprintf(b, "\n%s\n", ref.Description)
+	}
+	if ref.Enveloped {
+		fmt.Fprint(b, "\nSuccessful responses are wrapped in "+
+			"`{\"code\": 0, \"message\": \"\", \"data\": ...}`, "+
+			"the types of responses below are the types of data.\n")
+	}
+	if len(ref.Codes) > 0 {
+		fmt.Fprint(b, "\n## Error codes\n\n")
+		writeCodes(b, ref.Codes)
+	}
+	for _, s := range ref.Sections {
+		fmt.Fprintf(b, "\n## %s\n", s.Name)
+		if s.Description != "" {
+			fmt.Fprintf(b, "\n%s\n", s.Description)
+		}
+		for _, op := range s.Operations {
+			writeOperation(b, op)
+		}
+	}
+	if len(ref.Schemas) > 0 {
+		fmt.Fprint(b, "\n## Schemas\n")
+		for _, s := range ref.Schemas {
+			fmt.Fprintf(b, "\n<a id=\"%s\"></a>\n\n### %s\n", s.Anchor, s.Name)
+			if s.Description != "" {
+				fmt.Fprintf(b, "\n%s\n", s.Description)
+			}
+			if len(s.Fields) == 0 {
+				fmt.Fprintf(b, "\nType: %s\n", markdownType(s.Type))
+				continue
+			}
+			fmt.Fprint(b, "\n| Field | Type | Required | Description |\n| --- | --- | --- | --- |\n")
+			for _, f := range s.Fields {
+				fmt.Fprintf(b, "| %s | %s | %s | %s |\n",
+					cell(f.Name), markdownType(f.Type), yes(f.Required), cell(f.Description))
+			}
+		}
+	}
+	return b.Flush()
+}
+
+func writeOperation(b *bufio.Writer, op operation) {
+	fmt.Fprintf(b, "\n<a id=\"%s\"></a>\n\n### %s %s\n", op.Anchor, op.Method, op.Path)
+	if op.Summary != "" {
+		fmt.Fprintf(b, "\n%s\n", op.Summary)
+	}
+	if op.Description != "" {
+		fmt.Fprintf(b, "\n%s\n", op.Description)
+	}
+	if op.Since != "" {
+		fmt.Fprintf(b, "\nSince %s.\n", op.Since)
+	}
+	if op.Deprecated {
+		msg := "Deprecated"
+		if op.Sunset != "" {
+			msg += ", will be removed after " + op.Sunset
+		}
+		if op.Replacement != "" {
+			msg += ", use " + op.Replacement + " instead"
+		}
+		fmt.Fprintf(b, "\n> **%s.**\n", msg)
+	}
+	if len(op.Parameters) > 0 {
+		fmt.Fprint(b, "\n**Parameters**\n\n| Name | In | Type | Required | Description |\n| --- | --- | --- | --- | --- |\n")
+		for _, p := range op.Parameters {
+			fmt.Fprintf(b, "| %s | %s | %s | %s | %s |\n",
+				cell(p.Name), p.In, markdownType(p.Type), yes(p.Required), cell(p.Description))
+		}
+	}
+	if op.Body != nil {
+		fmt.Fprintf(b, "\n**Request body** (%s)\n\n| Type | Required | Description |\n| --- | --- | --- |\n", op.Body.MediaTypes)
+		fmt.Fprintf(b, "| %s | %s | %s |\n", markdownType(op.Body.Type), yes(op.Body.Required), cell(op.Body.Description))
+	}
+	if len(op.Responses) > 0 {
+		fmt.Fprint(b, "\n**Responses**\n\n| Status | Type | Description |\n| --- | --- | --- |\n")
+		for _, r := range op.Responses {
+			fmt.Fprintf(b, "| %s | %s | %s |\n", r.Status, markdownType(r.Type), cell(r.Description))
+		}
+	}
+	if len(op.Codes) > 0 {
+		fmt.Fprint(b, "\n**Error codes**\n\n")
+		writeCodes(b, op.Codes)
+	}
+}
+
+func writeCodes(b *bufio.Writer, codes []code) {
+	fmt.Fprint(b, "| Code | Message |\n| --- | --- |\n")
+	for _, c := range codes {
+		fmt.Fprintf(b, "| %s | %s |\n", c.Code, cell(c.Message))
+	}
+}
+
+// markdownType links the type to its schema.
+func markdownType(t typeRef) string {
+	if t.Text == "" {
+		return ""
+	}
+	text := "`" + strings.ReplaceAll(t.Text, "`", "'") + "`"
+	if t.Schema == "" {
+		return cell(text)
+	}
+	return "[" + cell(text) + "](#" + anchor("schema", t.Schema) + ")"
+}
+
+// cell escapes s in a table cell.
+func cell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+func yes(ok bool) string {
+	if ok {
+		return "yes"
+	}
+	return "no"
+}
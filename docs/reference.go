@@ -0,0 +1,392 @@
+// Package docs renders the API documents of biu as self-contained references
+// in Markdown or HTML, which are published without a running server.
+//
+//	doc, _ := c.BuildOpenAPI(info)
+//	f, _ := os.Create("api.md")
+//	_ = docs.Markdown(f, doc)
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+const (
+	schemaPrefix = "#/components/schemas/"
+	// envelopePrefix is the name prefix of the schemas of box.CommonResp,
+	// whose data are documented as the types of responses.
+	envelopePrefix = "box.CommonResp"
+	defaultTag     = "default"
+)
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// reference is the content of a document rendered by Markdown and HTML.
+type reference struct {
+	Title       string
+	Version     string
+	Description string
+	// Enveloped reports whether any response is wrapped in box.CommonResp.
+	Enveloped bool
+	Codes     []code
+	Sections  []section
+	Schemas   []schema
+}
+
+// code is a business error code and its message.
+type code struct {
+	Code    string
+	Message string
+}
+
+// section is the operations of a tag.
+type section struct {
+	Name        string
+	Description string
+	Operations  []operation
+}
+
+type operation struct {
+	Anchor      string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Deprecated  bool
+	Sunset      string
+	Replacement string
+	Since       string
+	Parameters  []field
+	Body        *body
+	Responses   []response
+	Codes       []code
+}
+
+// field is a parameter of an operation or a property of a schema.
+type field struct {
+	Name        string
+	In          string
+	Type        typeRef
+	Required    bool
+	Description string
+}
+
+type body struct {
+	MediaTypes  string
+	Type        typeRef
+	Required    bool
+	Description string
+}
+
+type response struct {
+	Status      string
+	Description string
+	Type        typeRef
+}
+
+type schema struct {
+	Name        string
+	Anchor      string
+	Description string
+	Type        typeRef
+	Fields      []field
+}
+
+// typeRef is the type of a value, Schema is the name of
+// the component schema it refers to, e.g. "[]User" refers to "User".
+type typeRef struct {
+	Text   string
+	Schema string
+}
+
+func newReference(doc *openapi.Document) *reference {
+	ref := &reference{Codes: codes(doc.Extensions)}
+	if doc.Info != nil {
+		ref.Title = doc.Info.Title
+		ref.Version = doc.Info.Version
+		ref.Description = doc.Info.Description
+	}
+	if ref.Title == "" {
+		ref.Title = "API Reference"
+	}
+
+	sections := make(map[string]*section)
+	var order []string
+	addSection := func(name, desc string) *section {
+		if s, ok := sections[name]; ok {
+			return s
+		}
+		s := &section{Name: name, Description: desc}
+		sections[name] = s
+		order = append(order, name)
+		return s
+	}
+	for _, tag := range doc.Tags {
+		addSection(tag.Name, tag.Description)
+	}
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		for _, method := range methods {
+			op := doc.Paths[path][method]
+			if op == nil {
+				continue
+			}
+			tag := defaultTag
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+			s := addSection(tag, "")
+			s.Operations = append(s.Operations, ref.operation(doc, method, path, op))
+		}
+	}
+	for _, name := range order {
+		if s := sections[name]; len(s.Operations) > 0 {
+			ref.Sections = append(ref.Sections, *s)
+		}
+	}
+
+	if doc.Components != nil {
+		names := make([]string, 0, len(doc.Components.Schemas))
+		for name := range doc.Components.Schemas {
+			if !strings.HasPrefix(name, envelopePrefix) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			s := doc.Components.Schemas[name]
+			ref.Schemas = append(ref.Schemas, schema{
+				Name:        name,
+				Anchor:      anchor("schema", name),
+				Description: s.Description,
+				Type:        typeOf(&s),
+				Fields:      properties(&s),
+			})
+		}
+	}
+	return ref
+}
+
+func (ref *reference) operation(doc *openapi.Document, method, path string, op *openapi.Operation) operation {
+	rst := operation{
+		Anchor:      anchor("op", method+" "+path),
+		Method:      strings.ToUpper(method),
+		Path:        path,
+		Summary:     op.Summary,
+		Description: op.Description,
+		Deprecated:  op.Deprecated,
+		Sunset:      extension(op.Extensions, openapi.ExtSunset),
+		Replacement: extension(op.Extensions, openapi.ExtReplacement),
+		Since:       extension(op.Extensions, openapi.ExtSince),
+		Codes:       codes(op.Extensions),
+	}
+	for _, p := range op.Parameters {
+		rst.Parameters = append(rst.Parameters, field{
+			Name:        p.Name,
+			In:          p.In,
+			Type:        typeOf(p.Schema),
+			Required:    p.Required,
+			Description: withEnum(p.Description, p.Schema),
+		})
+	}
+	if op.RequestBody != nil {
+		mediaTypes := sortedKeys(op.RequestBody.Content)
+		b := &body{
+			MediaTypes:  strings.Join(mediaTypes, ", "),
+			Required:    op.RequestBody.Required,
+			Description: op.RequestBody.Description,
+		}
+		if len(mediaTypes) > 0 {
+			b.Type = typeOf(op.RequestBody.Content[mediaTypes[0]].Schema)
+		}
+		rst.Body = b
+	}
+	for _, status := range sortedKeys(op.Responses) {
+		resp := op.Responses[status]
+		r := response{Status: status, Description: resp.Description}
+		if mediaTypes := sortedKeys(resp.Content); len(mediaTypes) > 0 {
+			s := resp.Content[mediaTypes[0]].Schema
+			if data, ok := envelopeData(doc, s); ok {
+				ref.Enveloped = true
+				s = data
+			}
+			r.Type = typeOf(s)
+		}
+		rst.Responses = append(rst.Responses, r)
+	}
+	return rst
+}
+
+// envelopeData returns the schema of data if s refers to a box.CommonResp,
+// it is nil for the envelope of errors, whose codes are listed instead.
+func envelopeData(doc *openapi.Document, s *spec.Schema) (*spec.Schema, bool) {
+	if s == nil || doc.Components == nil {
+		return nil, false
+	}
+	name := refName(s)
+	if !strings.HasPrefix(name, envelopePrefix) {
+		return nil, false
+	}
+	if name == envelopePrefix {
+		return nil, true
+	}
+	data, ok := doc.Components.Schemas[name].Properties["data"]
+	if !ok {
+		return nil, false
+	}
+	return &data, true
+}
+
+// typeOf returns the type of s, which is the name of the schema it refers to,
+// or the type and format, e.g. "integer(int64)", "[]User" and "map[string]User".
+func typeOf(s *spec.Schema) typeRef {
+	switch {
+	case s == nil:
+		return typeRef{}
+	case refName(s) != "":
+		name := refName(s)
+		return typeRef{Text: name, Schema: name}
+	case s.Type.Contains("array") && s.Items != nil && s.Items.Schema != nil:
+		item := typeOf(s.Items.Schema)
+		return typeRef{Text: "[]" + item.Text, Schema: item.Schema}
+	case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+		value := typeOf(s.AdditionalProperties.Schema)
+		return typeRef{Text: "map[string]" + value.Text, Schema: value.Schema}
+	}
+	var types []string
+	for _, t := range s.Type {
+		if t != "null" {
+			types = append(types, t)
+		}
+	}
+	text := strings.Join(types, "|")
+	switch {
+	case text == "" && len(s.Properties) > 0:
+		text = "object"
+	case text == "":
+		text = "any"
+	}
+	if s.Format != "" {
+		text += "(" + s.Format + ")"
+	}
+	return typeRef{Text: text}
+}
+
+func refName(s *spec.Schema) string {
+	ref := s.Ref.String()
+	if !strings.HasPrefix(ref, schemaPrefix) {
+		return ""
+	}
+	name, err := url.PathUnescape(strings.TrimPrefix(ref, schemaPrefix))
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// properties returns the fields of an object schema.
+func properties(s *spec.Schema) []field {
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	var fields []field
+	for _, name := range sortedKeys(s.Properties) {
+		prop := s.Properties[name]
+		fields = append(fields, field{
+			Name:        name,
+			Type:        typeOf(&prop),
+			Required:    required[name],
+			Description: withEnum(prop.Description, &prop),
+		})
+	}
+	return fields
+}
+
+// withEnum appends the possible values of s or its items to desc.
+func withEnum(desc string, s *spec.Schema) string {
+	if s != nil && len(s.Enum) == 0 && s.Items != nil {
+		s = s.Items.Schema
+	}
+	if s == nil || len(s.Enum) == 0 {
+		return desc
+	}
+	values := make([]string, 0, len(s.Enum))
+	for _, v := range s.Enum {
+		values = append(values, fmt.Sprint(v))
+	}
+	enum := "One of: " + strings.Join(values, ", ") + "."
+	if desc == "" {
+		return enum
+	}
+	return desc + " " + enum
+}
+
+// codes returns the business error codes of ExtCodes in numeric order.
+func codes(ext openapi.Extensions) []code {
+	var m map[string]string
+	if bs, err := json.Marshal(ext[openapi.ExtCodes]); err == nil {
+		_ = json.Unmarshal(bs, &m)
+	}
+	rst := make([]code, 0, len(m))
+	for k, v := range m {
+		rst = append(rst, code{Code: k, Message: v})
+	}
+	sort.Slice(rst, func(i, j int) bool {
+		a, errA := strconv.Atoi(rst[i].Code)
+		b, errB := strconv.Atoi(rst[j].Code)
+		if errA != nil || errB != nil {
+			return rst[i].Code < rst[j].Code
+		}
+		return a < b
+	})
+	return rst
+}
+
+func extension(ext openapi.Extensions, key string) string {
+	if v, ok := ext[key]; ok && v != nil {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// anchor returns the id of an element in the reference,
+// the characters other than letters and digits are replaced by "-".
+func anchor(kind, name string) string {
+	var b strings.Builder
+	b.WriteString(kind)
+	dash := true
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
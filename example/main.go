@@ -56,7 +56,8 @@ func main() {
 	)
 	// Note: you should add swagger service after adding services.
 	// swagger document will be available at http://localhost:8080/v1/swagger
-	swaggerService := c.NewSwaggerService(biu.SwaggerInfo{
+	// and the OpenAPI 3.1 document will be available at http://localhost:8080/v1/openapi
+	info := biu.SwaggerInfo{
 		Title:        "Foo Bar",
 		Description:  "Foo Bar Service",
 		ContactName:  "tuotoo",
@@ -64,7 +65,8 @@ func main() {
 		ContactURL:   "https://tuotoo.com",
 		Version:      "1.0.0",
 		RoutePrefix:  "/v1",
-	})
-	c.Add(swaggerService)
+	}
+	c.Add(c.NewSwaggerService(info))
+	c.Add(c.NewOpenAPIService(info))
 	c.Run(":8080", nil)
 }
@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
 	"syscall"
 	"testing"
@@ -20,10 +21,12 @@ import (
 	"github.com/emicklei/go-restful/v3"
 	"github.com/gavv/httpexpect/v2"
 	"github.com/go-openapi/spec"
+	"github.com/gorilla/websocket"
 
 	"github.com/tuotoo/biu/box"
 	"github.com/tuotoo/biu/internal"
 	"github.com/tuotoo/biu/log"
+	"github.com/tuotoo/biu/openapi"
 	"github.com/tuotoo/biu/opt"
 )
 
@@ -32,10 +35,37 @@ const (
 	MIME_HTML_FORM = "application/x-www-form-urlencoded"
 	// MIME_FILE_FORM is multipart/form-data
 	MIME_FILE_FORM = "multipart/form-data"
+	// MIME_EVENT_STREAM is text/event-stream
+	MIME_EVENT_STREAM = "text/event-stream"
 )
 
 var AutoGenPathDoc = false
 
+const (
+	// metaDownload lists the statuses of a route responded with a file.
+	metaDownload = "download"
+	// metaJWT marks a route is secured by JWT.
+	metaJWT = "jwt"
+	// metaSecurity lists the security requirements of a route.
+	metaSecurity = "security"
+	// metaExamples holds the routeExamples of a route.
+	metaExamples = "examples"
+	// metaHidden marks a route is excluded from documents.
+	metaHidden = "hidden"
+	// metaNamespace is the NS name of a route added by AddServices.
+	metaNamespace = "namespace"
+	// metaNoEnvelope marks a route is not wrapped in box.CommonResp.
+	metaNoEnvelope = "noEnvelope"
+	// metaMaxBodySize is the opt.MaxBodySize of a route.
+	metaMaxBodySize = "maxBodySize"
+)
+
+// routeExamples are the named examples of the body and returns of a route.
+type routeExamples struct {
+	body    map[string]interface{}
+	returns map[int]map[string]interface{}
+}
+
 // Route creates a new Route using the RouteBuilder
 // and add to the ordered list of Routes.
 func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
@@ -43,12 +73,26 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 		EnableAutoPathDoc: true,
 		To:                func(ctx box.Ctx) {},
 	}
+	for _, f := range ws.Container.routeDefaults {
+		f(cfg)
+	}
 	for _, f := range opts {
 		if f != nil {
 			f(cfg)
 		}
 	}
-	builder = builder.To(ws.Container.Handle(cfg.To))
+	if cfg.WebSocket != nil {
+		cfg.To = ws.Container.webSocketHandler(cfg.WebSocket)
+	}
+	handler := cfg.To
+	if cfg.NoEnvelope {
+		handler = func(ctx box.Ctx) {
+			ctx.SetAttribute(box.BiuAttrNoEnvelope, true)
+			cfg.To(ctx)
+		}
+		builder = builder.Metadata(metaNoEnvelope, true)
+	}
+	builder = builder.To(ws.Container.Handle(handler))
 	if cfg.ID != "" {
 		builder = builder.Operation(cfg.ID)
 	} else {
@@ -68,16 +112,21 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 	method := elm.FieldByName("httpMethod").String()
 	mapKey := routePath + " " + method
 
+	var cookies []opt.ParamOpt
+	var downloads []int
+	var examples routeExamples
 	for _, v := range cfg.Params {
 		switch v.FieldType {
+		case opt.FieldCookie:
+			cookies = append(cookies, v)
 		case opt.FieldQuery:
-			param := ws.QueryParameter(v.Name, v.Desc).DataType(v.Type).DataFormat(v.Format)
+			param := constrain(ws.QueryParameter(v.DocName(), v.Desc).DataType(v.Type).DataFormat(v.Format), v)
 			if v.IsMulti {
 				param = param.AllowMultiple(true).CollectionFormat("multi")
 			}
 			builder = builder.Param(param)
 		case opt.FieldForm:
-			param := ws.FormParameter(v.Name, v.Desc).DataType(v.Type).DataFormat(v.Format)
+			param := constrain(ws.FormParameter(v.DocName(), v.Desc).DataType(v.Type).DataFormat(v.Format), v)
 			if v.IsMulti {
 				param = param.AllowMultiple(true).CollectionFormat("multi")
 			}
@@ -87,17 +136,42 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 			}
 		case opt.FieldBody:
 			builder = builder.Reads(v.Body, v.Desc)
+			examples.body = v.Examples
 		case opt.FieldPath:
-			param := ws.PathParameter(v.Name, v.Desc).DataType(v.Type).DataFormat(v.Format)
+			param := constrain(ws.PathParameter(v.Name, v.Desc).DataType(v.Type).DataFormat(v.Format), v)
 			builder = builder.Param(param)
 		case opt.FieldHeader:
-			param := ws.HeaderParameter(v.Name, v.Desc).DataType(v.Type).DataFormat(v.Format)
+			param := constrain(ws.HeaderParameter(v.Name, v.Desc).DataType(v.Type).DataFormat(v.Format), v)
 			if v.IsMulti {
 				param = param.AllowMultiple(true).CollectionFormat("multi")
 			}
 			builder = builder.Param(param)
 		case opt.FieldReturn:
-			builder = builder.Returns(200, v.Desc, v.Return)
+			status := v.Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if len(v.Examples) > 0 {
+				if examples.returns == nil {
+					examples.returns = make(map[int]map[string]interface{})
+				}
+				examples.returns[status] = v.Examples
+			}
+			if _, ok := v.Return.(*box.Download); ok {
+				builder = builder.Produces(restful.MIME_OCTET, restful.MIME_JSON).
+					Returns(status, v.Desc, nil)
+				downloads = append(downloads, status)
+				continue
+			}
+			if len(v.Headers) == 0 {
+				builder = builder.Returns(status, v.Desc, v.Return)
+				continue
+			}
+			headers := make(map[string]restful.Header)
+			for _, h := range v.Headers {
+				headers[h] = restful.Header{Items: &restful.Items{Type: "string"}}
+			}
+			builder = builder.ReturnsWithHeaders(status, v.Desc, v.Return, headers)
 		case opt.FieldUnknown:
 			var param *restful.Parameter
 			switch method {
@@ -111,7 +185,7 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 			default:
 				continue
 			}
-			param = param.DataType(v.Type).DataFormat(v.Format)
+			param = constrain(param.DataType(v.Type).DataFormat(v.Format), v)
 			if v.IsMulti {
 				param = param.AllowMultiple(true).CollectionFormat("multi")
 			}
@@ -119,6 +193,16 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 		}
 	}
 
+	if len(downloads) > 0 {
+		builder = builder.Metadata(metaDownload, downloads)
+	}
+	if len(cookies) > 0 {
+		builder = builder.AddExtension(openapi.ExtCookies, cookieExtension(cookies))
+	}
+	if len(examples.body) > 0 || len(examples.returns) > 0 {
+		builder = builder.Metadata(metaExamples, examples)
+	}
+
 	if AutoGenPathDoc && cfg.EnableAutoPathDoc {
 		exp, err := internal.NewPathExpression(p2)
 		if err != nil {
@@ -145,11 +229,50 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 	}
 	for k, v := range cfg.Errors {
 		ws.errors[mapKey][k] = v
-		builder = builder.Returns(k, v, nil)
+	}
+	if len(cfg.Errors) > 0 {
+		builder = builder.AddExtension(openapi.ExtCodes, errorCodes(cfg.Errors))
 	}
 
 	if cfg.Auth {
-		builder = builder.Metadata("jwt", true)
+		builder = builder.Metadata(metaJWT, true)
+	}
+	if len(cfg.Security) > 0 {
+		builder = builder.Metadata(metaSecurity, cfg.Security)
+	}
+
+	if cfg.EventStream {
+		builder = builder.Produces(MIME_EVENT_STREAM)
+	}
+
+	if cfg.Hidden {
+		builder = builder.Metadata(metaHidden, true)
+	}
+	if cfg.Since != "" {
+		builder = builder.AddExtension(openapi.ExtSince, cfg.Since)
+	}
+	if cfg.Deprecated {
+		builder = builder.Deprecate()
+		if !cfg.Sunset.IsZero() {
+			builder = builder.AddExtension(openapi.ExtSunset, cfg.Sunset.UTC().Format(time.DateOnly))
+		}
+		if cfg.Replacement != "" {
+			builder = builder.AddExtension(openapi.ExtReplacement, cfg.Replacement)
+		}
+		builder.Filter(Filter(ws.Container.deprecationFilter(method, routePath, cfg)))
+	}
+
+	if cfg.MaxBodySize > 0 {
+		builder = builder.Metadata(metaMaxBodySize, cfg.MaxBodySize)
+	}
+	if cfg.MaxBodySize > 0 || cfg.MultipartLimits != (box.MultipartLimits{}) {
+		builder.Filter(Filter(func(ctx box.Ctx) {
+			if cfg.MaxBodySize > 0 {
+				ctx.Req().Body = http.MaxBytesReader(ctx.Resp(), ctx.Req().Body, cfg.MaxBodySize)
+			}
+			ctx.SetAttribute(box.BiuAttrMultipartLimits, cfg.MultipartLimits)
+			ctx.Next()
+		}))
 	}
 
 	builder.Filter(Filter(func(ctx box.Ctx) {
@@ -168,6 +291,77 @@ func (ws WS) Route(builder *restful.RouteBuilder, opts ...opt.RouteFunc) {
 	ws.WebService.Route(builder)
 }
 
+// cookieExtension lists the cookie parameters of a route,
+// since swagger 2 can not describe cookies, they are documented
+// in an extension of the operation to be converted to cookie parameters of OpenAPI 3,
+// and noted in the description of the operation by processCookies.
+func cookieExtension(cookies []opt.ParamOpt) []map[string]interface{} {
+	ext := make([]map[string]interface{}, 0, len(cookies))
+	for _, v := range cookies {
+		cookie := map[string]interface{}{
+			"name":        v.Name,
+			"description": v.Desc,
+			"required":    v.Required,
+			"type":        v.Type,
+			"format":      v.Format,
+		}
+		if v.Example != "" {
+			cookie["example"] = typedDefault(v.Example, v.Type)
+		}
+		ext = append(ext, cookie)
+	}
+	return ext
+}
+
+// errorCodes returns the messages of business error codes,
+// they are responded in CommonResp instead of HTTP status.
+func errorCodes(errors map[int]string) map[string]string {
+	rst := make(map[string]string, len(errors))
+	for k, v := range errors {
+		rst[strconv.Itoa(k)] = v
+	}
+	return rst
+}
+
+// constrain documents the validation constraints and default value of a RouteAPI parameter.
+func constrain(param *restful.Parameter, v opt.ParamOpt) *restful.Parameter {
+	if v.Required {
+		param = param.Required(true)
+	}
+	if v.Minimum != nil {
+		param = param.Minimum(*v.Minimum)
+	}
+	if v.Maximum != nil {
+		param = param.Maximum(*v.Maximum)
+	}
+	if v.MinLength != nil {
+		param = param.MinLength(*v.MinLength)
+	}
+	if v.MaxLength != nil {
+		param = param.MaxLength(*v.MaxLength)
+	}
+	if v.Pattern != "" {
+		param = param.Pattern(v.Pattern)
+	}
+	if len(v.Enum) > 0 {
+		param = param.PossibleValues(v.Enum)
+	}
+	if v.Default != "" {
+		param = param.DefaultValue(v.Default)
+	}
+	if v.Example != "" {
+		param = param.AddExtension(openapi.ExtExample, v.Example)
+	}
+	if v.IsMap {
+		style := v.Style
+		if style == "" {
+			style = opt.StyleDeepObject
+		}
+		param = param.AddExtension(openapi.ExtMap, map[string]interface{}{"name": v.Name, "style": style})
+	}
+	return param
+}
+
 func addService(
 	prefix string,
 	opts opt.ServicesFuncArr,
@@ -225,7 +419,7 @@ func addService(
 				URL:         v.ExternalURL,
 			}
 		}
-		container.swaggerTags[container.ServeMux] = append(container.swaggerTags[container.ServeMux], spec.Tag{
+		container.swaggerTags = append(container.swaggerTags, spec.Tag{
 			TagProps: tagProps,
 		})
 		routes := ws.Routes()
@@ -233,6 +427,9 @@ func addService(
 			if routes[ri].Metadata == nil {
 				routes[ri].Metadata = make(map[string]interface{})
 			}
+			if _, ok := routes[ri].Metadata[metaNamespace]; !ok {
+				routes[ri].Metadata[metaNamespace] = v.NameSpace
+			}
 			if len(routes[ri].Consumes) == 0 {
 				if r.Method == "POST" || r.Method == "PUT" || r.Method == "PATCH" {
 					r.Consumes = []string{MIME_HTML_FORM}
@@ -314,6 +511,7 @@ func run(addr string, c *Container, opts ...opt.RunFunc) {
 		Addr:    addr,
 		Handler: c,
 	}
+	c.Server.RegisterOnShutdown(c.CloseWebSockets)
 	addrChan := make(chan string)
 
 	go func() {
@@ -359,6 +557,7 @@ func run(addr string, c *Container, opts ...opt.RunFunc) {
 // TestServer wraps a httptest.Server
 type TestServer struct {
 	*httptest.Server
+	container *Container
 }
 
 // WithT accept testing.T and returns httpexpect.Expect
@@ -366,6 +565,19 @@ func (s *TestServer) WithT(t *testing.T) *httpexpect.Expect {
 	return httpexpect.New(t, s.URL)
 }
 
+// DialWebSocket connects to a websocket route of the test server.
+func (s *TestServer) DialWebSocket(path string, header http.Header) (*websocket.Conn, *http.Response, error) {
+	return websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(s.URL, "http")+path, header)
+}
+
+// Close closes all websocket connections and shuts down the test server.
+func (s *TestServer) Close() {
+	if s.container != nil {
+		s.container.CloseWebSockets()
+	}
+	s.Server.Close()
+}
+
 // LogFilter logs
 //
 //	{
@@ -0,0 +1,519 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+const (
+	mimeJSON      = "application/json"
+	mimeOctet     = "application/octet-stream"
+	mimeMultipart = "multipart/form-data"
+	mimeForm      = "application/x-www-form-urlencoded"
+
+	// StyleDeepObject is the style of map parameters like filter[status].
+	StyleDeepObject = "deepObject"
+)
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// FromSwagger converts a swagger 2.0 document to OpenAPI 3.1,
+// swo is not modified.
+func FromSwagger(swo *spec.Swagger) *Document {
+	doc := &Document{
+		OpenAPI:      Version,
+		Info:         swo.Info,
+		Servers:      servers(swo),
+		Paths:        make(map[string]PathItem),
+		Security:     security(swo.Security),
+		Tags:         swo.Tags,
+		ExternalDocs: swo.ExternalDocs,
+		Extensions:   extensions(swo.Extensions),
+	}
+	if swo.Paths != nil {
+		for path, item := range swo.Paths.Paths {
+			ops := []*spec.Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch}
+			pathItem := make(PathItem)
+			for i, op := range ops {
+				if op != nil {
+					pathItem[methods[i]] = convertOperation(swo, op)
+				}
+			}
+			doc.Paths[path] = pathItem
+		}
+	}
+	components := &Components{}
+	for name, def := range swo.Definitions {
+		if components.Schemas == nil {
+			components.Schemas = make(map[string]spec.Schema)
+		}
+		components.Schemas[name] = *convertSchema(&def)
+	}
+	for name, scheme := range swo.SecurityDefinitions {
+		if components.SecuritySchemes == nil {
+			components.SecuritySchemes = make(map[string]*SecurityScheme)
+		}
+		components.SecuritySchemes[name] = convertSecurityScheme(scheme)
+	}
+	if components.Schemas != nil || components.SecuritySchemes != nil {
+		doc.Components = components
+	}
+	return doc
+}
+
+func servers(swo *spec.Swagger) []Server {
+	if swo.Host == "" {
+		if swo.BasePath == "" {
+			return nil
+		}
+		return []Server{{URL: swo.BasePath}}
+	}
+	if len(swo.Schemes) == 0 {
+		return []Server{{URL: "//" + swo.Host + swo.BasePath}}
+	}
+	rst := make([]Server, 0, len(swo.Schemes))
+	for _, scheme := range swo.Schemes {
+		rst = append(rst, Server{URL: scheme + "://" + swo.Host + swo.BasePath})
+	}
+	return rst
+}
+
+func convertOperation(swo *spec.Swagger, op *spec.Operation) *Operation {
+	rst := &Operation{
+		Tags:         op.Tags,
+		Summary:      op.Summary,
+		Description:  op.Description,
+		ExternalDocs: op.ExternalDocs,
+		OperationID:  op.ID,
+		Deprecated:   op.Deprecated,
+		Security:     security(op.Security),
+		Extensions:   extensions(op.Extensions),
+	}
+	consumes := op.Consumes
+	if len(consumes) == 0 {
+		consumes = swo.Consumes
+	}
+	produces := op.Produces
+	if len(produces) == 0 {
+		produces = swo.Produces
+	}
+	var form []spec.Parameter
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "body":
+			rst.RequestBody = &RequestBody{
+				Description: p.Description,
+				Required:    p.Required,
+				Content:     content(consumes, convertSchema(p.Schema)),
+			}
+			addExamples(rst.RequestBody.Content, p.Extensions[ExtNamedExamples])
+		case "formData":
+			form = append(form, p)
+		default:
+			rst.Parameters = append(rst.Parameters, convertParameter(p))
+		}
+	}
+	if cookies, ok := op.Extensions[ExtCookies]; ok {
+		delete(rst.Extensions, ExtCookies)
+		rst.Description = withoutCookieNote(rst.Description, cookies)
+		rst.Parameters = append(rst.Parameters, cookieParameters(cookies)...)
+	}
+	if len(form) > 0 {
+		rst.RequestBody = formBody(consumes, form)
+	}
+	if op.Responses != nil {
+		rst.Responses = make(map[string]*Response)
+		if op.Responses.Default != nil {
+			rst.Responses["default"] = convertResponse(op.Responses.Default, produces)
+		}
+		for code, resp := range op.Responses.StatusCodeResponses {
+			resp := resp
+			rst.Responses[strconv.Itoa(code)] = convertResponse(&resp, produces)
+		}
+	}
+	return rst
+}
+
+// convertParameter converts a parameter of path, query or header.
+func convertParameter(p spec.Parameter) Parameter {
+	rst := Parameter{
+		Name:        p.Name,
+		In:          p.In,
+		Description: p.Description,
+		Required:    p.Required,
+		Schema:      simpleSchema(p.SimpleSchema, p.CommonValidations),
+		Extensions:  extensions(p.Extensions),
+	}
+	delete(rst.Extensions, ExtMap)
+	delete(rst.Extensions, ExtExample)
+	rst.Example = p.Extensions[ExtExample]
+	if m, ok := p.Extensions[ExtMap]; ok {
+		var v struct {
+			Name  string `json:"name"`
+			Style string `json:"style"`
+		}
+		if decodeExtension(m, &v) == nil && v.Style == StyleDeepObject {
+			explode := true
+			rst.Name = v.Name
+			rst.Style = StyleDeepObject
+			rst.Explode = &explode
+			rst.Schema = &spec.Schema{SchemaProps: spec.SchemaProps{
+				Type:                 spec.StringOrArray{"object"},
+				AdditionalProperties: &spec.SchemaOrBool{Allows: true, Schema: rst.Schema},
+			}}
+		}
+	}
+	if p.Type == "array" {
+		switch p.CollectionFormat {
+		case "multi":
+		case "pipes":
+			rst.Style = "pipeDelimited"
+		case "ssv":
+			rst.Style = "spaceDelimited"
+		default:
+			if p.In == "query" {
+				explode := false
+				rst.Explode = &explode
+			}
+		}
+	}
+	return rst
+}
+
+func cookieParameters(ext interface{}) []Parameter {
+	cookies := decodeCookies(ext)
+	rst := make([]Parameter, 0, len(cookies))
+	for _, c := range cookies {
+		rst = append(rst, Parameter{
+			Name:        c.Name,
+			In:          "cookie",
+			Description: c.Description,
+			Required:    c.Required,
+			Schema:      typeSchema(c.Type, c.Format),
+		})
+		rst[len(rst)-1].Example = c.Example
+	}
+	return rst
+}
+
+// cookie is a cookie parameter in the ExtCookies extension.
+type cookie struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Required    bool        `json:"required"`
+	Type        string      `json:"type"`
+	Format      string      `json:"format"`
+	Example     interface{} `json:"example"`
+}
+
+func decodeCookies(ext interface{}) []cookie {
+	var cookies []cookie
+	if decodeExtension(ext, &cookies) != nil {
+		return nil
+	}
+	return cookies
+}
+
+// CookieNote lists the cookies in the ExtCookies extension in markdown,
+// it is appended to the description of an operation in swagger 2.0,
+// whose renderers do not show the extension.
+func CookieNote(ext interface{}) string {
+	cookies := decodeCookies(ext)
+	if len(cookies) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("**Cookies**\n")
+	for _, c := range cookies {
+		b.WriteString("\n- `" + c.Name + "` (" + c.Type)
+		if c.Required {
+			b.WriteString(", required")
+		}
+		b.WriteString(")")
+		if c.Description != "" {
+			b.WriteString(": " + c.Description)
+		}
+	}
+	return b.String()
+}
+
+// withoutCookieNote removes the CookieNote of ext from the description,
+// the cookies are parameters in OpenAPI 3.
+func withoutCookieNote(desc string, ext interface{}) string {
+	note := CookieNote(ext)
+	if note == "" || !strings.HasSuffix(desc, note) {
+		return desc
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(desc, note), "\n\n")
+}
+
+// formBody converts the form parameters to the schema of request body.
+func formBody(consumes []string, params []spec.Parameter) *RequestBody {
+	schema := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:       spec.StringOrArray{"object"},
+		Properties: make(spec.SchemaProperties),
+	}}
+	mime := mimeForm
+	for _, p := range params {
+		prop := simpleSchema(p.SimpleSchema, p.CommonValidations)
+		prop.Description = p.Description
+		if p.Type == "file" {
+			mime = mimeMultipart
+		}
+		schema.Properties[p.Name] = *prop
+		if p.Required {
+			schema.Required = append(schema.Required, p.Name)
+		}
+	}
+	for _, v := range consumes {
+		if v == mimeMultipart {
+			mime = mimeMultipart
+		}
+	}
+	return &RequestBody{
+		Required: len(schema.Required) > 0,
+		Content:  map[string]MediaType{mime: {Schema: schema}},
+	}
+}
+
+func convertResponse(resp *spec.Response, produces []string) *Response {
+	rst := &Response{
+		Description: resp.Description,
+		Extensions:  extensions(resp.Extensions),
+	}
+	delete(rst.Extensions, ExtNamedExamples)
+	for name, h := range resp.Headers {
+		if rst.Headers == nil {
+			rst.Headers = make(map[string]Header)
+		}
+		rst.Headers[name] = Header{
+			Description: h.Description,
+			Schema:      simpleSchema(h.SimpleSchema, h.CommonValidations),
+		}
+	}
+	if resp.Schema == nil {
+		return rst
+	}
+	if resp.Schema.Type.Contains("file") {
+		rst.Content = map[string]MediaType{mimeOctet: {Schema: typeSchema("file", "")}}
+		return rst
+	}
+	rst.Content = content(produces, convertSchema(resp.Schema))
+	if _, ok := resp.Extensions[ExtNamedExamples]; ok {
+		addExamples(rst.Content, resp.Extensions[ExtNamedExamples])
+		return rst
+	}
+	for mime, v := range resp.Examples {
+		if c, ok := rst.Content[mime]; ok {
+			c.Example = v
+			rst.Content[mime] = c
+		}
+	}
+	return rst
+}
+
+// addExamples adds the named examples of ExtNamedExamples to each media type of content.
+func addExamples(content map[string]MediaType, ext interface{}) {
+	var named map[string]interface{}
+	if ext == nil || decodeExtension(ext, &named) != nil || len(named) == 0 {
+		return
+	}
+	examples := make(map[string]Example, len(named))
+	for name, v := range named {
+		examples[name] = Example{Value: v}
+	}
+	for mime, c := range content {
+		c.Examples = examples
+		content[mime] = c
+	}
+}
+
+// content returns the content of a schema in media types,
+// the default media type is application/json.
+func content(mimes []string, schema *spec.Schema) map[string]MediaType {
+	if len(mimes) == 0 {
+		mimes = []string{mimeJSON}
+	}
+	rst := make(map[string]MediaType, len(mimes))
+	for _, mime := range mimes {
+		rst[mime] = MediaType{Schema: schema}
+	}
+	return rst
+}
+
+// simpleSchema converts the type and validations of a non-body parameter to schema.
+func simpleSchema(s spec.SimpleSchema, v spec.CommonValidations) *spec.Schema {
+	schema := typeSchema(s.Type, s.Format)
+	schema.Default = s.Default
+	schema.Example = s.Example
+	schema.Maximum = v.Maximum
+	schema.ExclusiveMaximum = v.ExclusiveMaximum
+	schema.Minimum = v.Minimum
+	schema.ExclusiveMinimum = v.ExclusiveMinimum
+	schema.MaxLength = v.MaxLength
+	schema.MinLength = v.MinLength
+	schema.Pattern = v.Pattern
+	schema.MaxItems = v.MaxItems
+	schema.MinItems = v.MinItems
+	schema.UniqueItems = v.UniqueItems
+	schema.MultipleOf = v.MultipleOf
+	schema.Enum = v.Enum
+	if s.Items != nil {
+		schema.Items = &spec.SchemaOrArray{Schema: simpleSchema(s.Items.SimpleSchema, s.Items.CommonValidations)}
+	}
+	return schema
+}
+
+// typeSchema returns the schema of a swagger type, file is converted to binary string.
+func typeSchema(typ, format string) *spec.Schema {
+	if typ == "file" {
+		typ, format = "string", "binary"
+	}
+	schema := &spec.Schema{}
+	if typ != "" {
+		schema.Type = spec.StringOrArray{typ}
+	}
+	schema.Format = format
+	return schema
+}
+
+// convertSchema returns a copy of schema, references are pointed to components.
+func convertSchema(schema *spec.Schema) *spec.Schema {
+	if schema == nil {
+		return nil
+	}
+	rst := *schema
+	if ref := schema.Ref.String(); strings.HasPrefix(ref, "#/definitions/") {
+		rst.Ref = spec.MustCreateRef("#/components/schemas/" + strings.TrimPrefix(ref, "#/definitions/"))
+	}
+	if rst.Type.Contains("file") {
+		rst.Type, rst.Format = spec.StringOrArray{"string"}, "binary"
+	}
+	if rst.Nullable {
+		rst.Nullable = false
+		rst.Type = append(rst.Type[:len(rst.Type):len(rst.Type)], "null")
+	}
+	if schema.Items != nil {
+		items := &spec.SchemaOrArray{Schema: convertSchema(schema.Items.Schema)}
+		for i := range schema.Items.Schemas {
+			items.Schemas = append(items.Schemas, *convertSchema(&schema.Items.Schemas[i]))
+		}
+		rst.Items = items
+	}
+	rst.Properties = convertSchemas(schema.Properties)
+	rst.PatternProperties = convertSchemas(schema.PatternProperties)
+	rst.Definitions = convertSchemas(schema.Definitions)
+	rst.AllOf = convertSchemaList(schema.AllOf)
+	rst.AnyOf = convertSchemaList(schema.AnyOf)
+	rst.OneOf = convertSchemaList(schema.OneOf)
+	rst.Not = convertSchema(schema.Not)
+	if schema.AdditionalProperties != nil {
+		rst.AdditionalProperties = &spec.SchemaOrBool{
+			Allows: schema.AdditionalProperties.Allows,
+			Schema: convertSchema(schema.AdditionalProperties.Schema),
+		}
+	}
+	return &rst
+}
+
+func convertSchemas(m map[string]spec.Schema) map[string]spec.Schema {
+	if m == nil {
+		return nil
+	}
+	rst := make(map[string]spec.Schema, len(m))
+	for k, v := range m {
+		rst[k] = *convertSchema(&v)
+	}
+	return rst
+}
+
+func convertSchemaList(list []spec.Schema) []spec.Schema {
+	if list == nil {
+		return nil
+	}
+	rst := make([]spec.Schema, 0, len(list))
+	for i := range list {
+		rst = append(rst, *convertSchema(&list[i]))
+	}
+	return rst
+}
+
+func convertSecurityScheme(s *spec.SecurityScheme) *SecurityScheme {
+	rst := &SecurityScheme{
+		Type:        s.Type,
+		Description: s.Description,
+		Name:        s.Name,
+		In:          s.In,
+	}
+	switch s.Type {
+	case "basic":
+		rst.Type, rst.Scheme = "http", "basic"
+	case "oauth2":
+		flow := &OAuthFlow{
+			AuthorizationURL: s.AuthorizationURL,
+			TokenURL:         s.TokenURL,
+			Scopes:           s.Scopes,
+		}
+		if flow.Scopes == nil {
+			flow.Scopes = make(map[string]string)
+		}
+		rst.Flows = &OAuthFlows{}
+		switch s.Flow {
+		case "implicit":
+			rst.Flows.Implicit = flow
+		case "password":
+			rst.Flows.Password = flow
+		case "application":
+			rst.Flows.ClientCredentials = flow
+		case "accessCode":
+			rst.Flows.AuthorizationCode = flow
+		}
+	}
+	return rst
+}
+
+// security returns a copy of requirements, the scopes are never null.
+func security(requirements []map[string][]string) []map[string][]string {
+	if requirements == nil {
+		return nil
+	}
+	rst := make([]map[string][]string, 0, len(requirements))
+	for _, req := range requirements {
+		m := make(map[string][]string, len(req))
+		for name, scopes := range req {
+			if scopes == nil {
+				scopes = []string{}
+			}
+			m[name] = scopes
+		}
+		rst = append(rst, m)
+	}
+	return rst
+}
+
+// extensions returns the specification extensions in ext.
+func extensions(ext spec.Extensions) Extensions {
+	var rst Extensions
+	for k, v := range ext {
+		if !strings.HasPrefix(strings.ToLower(k), "x-") {
+			continue
+		}
+		if rst == nil {
+			rst = make(Extensions)
+		}
+		rst[k] = v
+	}
+	return rst
+}
+
+// decodeExtension decodes the value of an extension into v.
+func decodeExtension(ext interface{}, v interface{}) error {
+	bs, err := json.Marshal(ext)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
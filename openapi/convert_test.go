@@ -0,0 +1,109 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+func TestFromSwagger(t *testing.T) {
+	swo := &spec.Swagger{
+		VendorExtensible: spec.VendorExtensible{Extensions: spec.Extensions{"x-codes": map[string]string{"1": "a"}}},
+		SwaggerProps: spec.SwaggerProps{
+			Swagger:  "2.0",
+			Host:     "example.com",
+			BasePath: "/api",
+			Schemes:  []string{"https"},
+			SecurityDefinitions: spec.SecurityDefinitions{
+				"basic": spec.BasicAuth(),
+				"oauth": spec.OAuth2AccessToken("https://example.com/auth", "https://example.com/token"),
+			},
+			Definitions: spec.Definitions{
+				"User": {SchemaProps: spec.SchemaProps{
+					Type: spec.StringOrArray{"object"},
+					Properties: spec.SchemaProperties{
+						"friends": *spec.ArrayProperty(spec.RefSchema("#/definitions/User")),
+						"avatar":  {SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"file"}}},
+					},
+				}},
+			},
+			Paths: &spec.Paths{Paths: map[string]spec.PathItem{
+				"/users": {PathItemProps: spec.PathItemProps{
+					Get: &spec.Operation{
+						VendorExtensible: spec.VendorExtensible{Extensions: spec.Extensions{"x-codes": 1, "other": 1}},
+						OperationProps: spec.OperationProps{
+							ID: "list",
+							Parameters: []spec.Parameter{
+								*spec.QueryParam("ids").CollectionOf(spec.NewItems().Typed("integer", ""), "csv"),
+								*spec.QueryParam("tags").CollectionOf(spec.NewItems().Typed("string", ""), "ssv"),
+								*spec.HeaderParam("X-Token").Typed("string", "").AsRequired(),
+							},
+							Responses: &spec.Responses{ResponsesProps: spec.ResponsesProps{
+								StatusCodeResponses: map[int]spec.Response{
+									200: *spec.NewResponse().WithDescription("ok").
+										WithSchema(spec.ArrayProperty(spec.RefSchema("#/definitions/User"))),
+									404: *spec.NewResponse().WithDescription("file").
+										WithSchema(&spec.Schema{SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"file"}}}),
+								},
+							}},
+						},
+					},
+				}},
+			}},
+		},
+	}
+	before, err := json.Marshal(swo)
+	assert.NoError(t, err)
+	bs, err := json.Marshal(openapi.FromSwagger(swo))
+	assert.NoError(t, err)
+	after, err := json.Marshal(swo)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(before), string(after))
+
+	assert.JSONEq(t, `{
+		"openapi": "3.1.0",
+		"servers": [{"url": "https://example.com/api"}],
+		"x-codes": {"1": "a"},
+		"paths": {
+			"/users": {
+				"get": {
+					"operationId": "list",
+					"x-codes": 1,
+					"parameters": [
+						{"name": "ids", "in": "query", "explode": false,
+							"schema": {"type": "array", "items": {"type": "integer"}}},
+						{"name": "tags", "in": "query", "style": "spaceDelimited",
+							"schema": {"type": "array", "items": {"type": "string"}}},
+						{"name": "X-Token", "in": "header", "required": true, "schema": {"type": "string"}}
+					],
+					"responses": {
+						"200": {"description": "ok", "content": {"application/json": {"schema": {
+							"type": "array", "items": {"$ref": "#/components/schemas/User"}}}}},
+						"404": {"description": "file", "content": {"application/octet-stream": {"schema": {
+							"type": "string", "format": "binary"}}}}
+					}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"User": {"type": "object", "properties": {
+					"friends": {"type": "array", "items": {"$ref": "#/components/schemas/User"}},
+					"avatar": {"type": "string", "format": "binary"}
+				}}
+			},
+			"securitySchemes": {
+				"basic": {"type": "http", "scheme": "basic"},
+				"oauth": {"type": "oauth2", "flows": {"authorizationCode": {
+					"authorizationUrl": "https://example.com/auth",
+					"tokenUrl": "https://example.com/token",
+					"scopes": {}
+				}}}
+			}
+		}
+	}`, string(bs))
+}
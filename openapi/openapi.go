@@ -0,0 +1,252 @@
+// Package openapi describes OpenAPI 3.1 documents,
+// which are converted from the swagger 2.0 documents of biu.
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// Version is the OpenAPI version of converted documents.
+const Version = "3.1.0"
+
+const (
+	// ExtCookies lists the cookie parameters of an operation in swagger 2.0,
+	// which can not describe cookies.
+	ExtCookies = "x-biu-cookies"
+	// ExtMap marks a parameter of map, its value has the name and style of the map.
+	ExtMap = "x-biu-map"
+	// ExtCodes lists the business error codes and messages of an operation,
+	// or the global ones in the root of a document.
+	ExtCodes = "x-codes"
+	// ExtExample is the example of a non-body parameter read by swagger ui.
+	ExtExample = "x-example"
+	// ExtExamples are the examples of a body parameter keyed by media type.
+	ExtExamples = "x-examples"
+	// ExtSince is the version in which an operation is added.
+	ExtSince = "x-since"
+	// ExtSunset is the date after which a deprecated operation will be removed.
+	ExtSunset = "x-sunset"
+	// ExtReplacement is the URL of the successor of a deprecated operation.
+	ExtReplacement = "x-replacement"
+	// ExtNamedExamples are the examples of a body parameter or a response keyed by name.
+	ExtNamedExamples = "x-biu-examples"
+)
+
+// Extensions are the specification extensions of an object,
+// the keys start with "x-".
+type Extensions map[string]interface{}
+
+// Document is the root of an OpenAPI document.
+type Document struct {
+	OpenAPI      string                      `json:"openapi"`
+	Info         *spec.Info                  `json:"info,omitempty"`
+	Servers      []Server                    `json:"servers,omitempty"`
+	Paths        map[string]PathItem         `json:"paths"`
+	Components   *Components                 `json:"components,omitempty"`
+	Security     []map[string][]string       `json:"security,omitempty"`
+	Tags         []spec.Tag                  `json:"tags,omitempty"`
+	ExternalDocs *spec.ExternalDocumentation `json:"externalDocs,omitempty"`
+	Extensions   Extensions                  `json:"-"`
+}
+
+// MarshalJSON marshals the document with its extensions.
+func (d Document) MarshalJSON() ([]byte, error) {
+	type document Document
+	return marshalExtensible(document(d), d.Extensions)
+}
+
+// UnmarshalJSON unmarshals the document with its extensions.
+func (d *Document) UnmarshalJSON(bs []byte) error {
+	type document Document
+	return unmarshalExtensible(bs, (*document)(d), &d.Extensions)
+}
+
+// Server is a server of the API.
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem contains the operations of a path keyed by lower case method.
+type PathItem map[string]*Operation
+
+// Operation describes an API operation on a path.
+type Operation struct {
+	Tags         []string                    `json:"tags,omitempty"`
+	Summary      string                      `json:"summary,omitempty"`
+	Description  string                      `json:"description,omitempty"`
+	ExternalDocs *spec.ExternalDocumentation `json:"externalDocs,omitempty"`
+	OperationID  string                      `json:"operationId,omitempty"`
+	Parameters   []Parameter                 `json:"parameters,omitempty"`
+	RequestBody  *RequestBody                `json:"requestBody,omitempty"`
+	Responses    map[string]*Response        `json:"responses,omitempty"`
+	Deprecated   bool                        `json:"deprecated,omitempty"`
+	Security     []map[string][]string       `json:"security,omitempty"`
+	Extensions   Extensions                  `json:"-"`
+}
+
+// MarshalJSON marshals the operation with its extensions.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	type operation Operation
+	return marshalExtensible(operation(o), o.Extensions)
+}
+
+// UnmarshalJSON unmarshals the operation with its extensions.
+func (o *Operation) UnmarshalJSON(bs []byte) error {
+	type operation Operation
+	return unmarshalExtensible(bs, (*operation)(o), &o.Extensions)
+}
+
+// Parameter is a parameter of path, query, header or cookie.
+type Parameter struct {
+	Name        string       `json:"name"`
+	In          string       `json:"in"`
+	Description string       `json:"description,omitempty"`
+	Required    bool         `json:"required,omitempty"`
+	Deprecated  bool         `json:"deprecated,omitempty"`
+	Style       string       `json:"style,omitempty"`
+	Explode     *bool        `json:"explode,omitempty"`
+	Schema      *spec.Schema `json:"schema,omitempty"`
+	Example     interface{}  `json:"example,omitempty"`
+	Extensions  Extensions   `json:"-"`
+}
+
+// MarshalJSON marshals the parameter with its extensions.
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	type parameter Parameter
+	return marshalExtensible(parameter(p), p.Extensions)
+}
+
+// UnmarshalJSON unmarshals the parameter with its extensions.
+func (p *Parameter) UnmarshalJSON(bs []byte) error {
+	type parameter Parameter
+	return unmarshalExtensible(bs, (*parameter)(p), &p.Extensions)
+}
+
+// RequestBody is the body of a request.
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+// MediaType is the content of a media type.
+type MediaType struct {
+	Schema   *spec.Schema       `json:"schema,omitempty"`
+	Example  interface{}        `json:"example,omitempty"`
+	Examples map[string]Example `json:"examples,omitempty"`
+}
+
+// Example is an example of a media type.
+type Example struct {
+	Summary string      `json:"summary,omitempty"`
+	Value   interface{} `json:"value"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Description string               `json:"description"`
+	Headers     map[string]Header    `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+	Extensions  Extensions           `json:"-"`
+}
+
+// MarshalJSON marshals the response with its extensions.
+func (r Response) MarshalJSON() ([]byte, error) {
+	type response Response
+	return marshalExtensible(response(r), r.Extensions)
+}
+
+// UnmarshalJSON unmarshals the response with its extensions.
+func (r *Response) UnmarshalJSON(bs []byte) error {
+	type response Response
+	return unmarshalExtensible(bs, (*response)(r), &r.Extensions)
+}
+
+// Header is a header of a response.
+type Header struct {
+	Description string       `json:"description,omitempty"`
+	Schema      *spec.Schema `json:"schema,omitempty"`
+}
+
+// Components holds the reusable objects of a document.
+type Components struct {
+	Schemas         map[string]spec.Schema     `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is a security scheme of operations.
+type SecurityScheme struct {
+	Type             string      `json:"type"`
+	Description      string      `json:"description,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	In               string      `json:"in,omitempty"`
+	Scheme           string      `json:"scheme,omitempty"`
+	BearerFormat     string      `json:"bearerFormat,omitempty"`
+	Flows            *OAuthFlows `json:"flows,omitempty"`
+	OpenIDConnectURL string      `json:"openIdConnectUrl,omitempty"`
+}
+
+// OAuthFlows are the flows of an oauth2 security scheme.
+type OAuthFlows struct {
+	Implicit          *OAuthFlow `json:"implicit,omitempty"`
+	Password          *OAuthFlow `json:"password,omitempty"`
+	ClientCredentials *OAuthFlow `json:"clientCredentials,omitempty"`
+	AuthorizationCode *OAuthFlow `json:"authorizationCode,omitempty"`
+}
+
+// OAuthFlow is an oauth2 flow.
+type OAuthFlow struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	RefreshURL       string            `json:"refreshUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes"`
+}
+
+// unmarshalExtensible unmarshals bs into v, and the extensions into ext.
+func unmarshalExtensible(bs []byte, v interface{}, ext *Extensions) error {
+	if err := json.Unmarshal(bs, v); err != nil {
+		return err
+	}
+	obj := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bs, &obj); err != nil {
+		return err
+	}
+	for k, raw := range obj {
+		if !strings.HasPrefix(strings.ToLower(k), "x-") {
+			continue
+		}
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		if *ext == nil {
+			*ext = make(Extensions)
+		}
+		(*ext)[k] = v
+	}
+	return nil
+}
+
+// marshalExtensible marshals v and adds the extensions to the object.
+func marshalExtensible(v interface{}, ext Extensions) ([]byte, error) {
+	bs, err := json.Marshal(v)
+	if err != nil || len(ext) == 0 {
+		return bs, err
+	}
+	obj := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bs, &obj); err != nil {
+		return nil, err
+	}
+	for k, v := range ext {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		obj[k] = raw
+	}
+	return json.Marshal(obj)
+}
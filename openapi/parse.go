@@ -0,0 +1,68 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/spec"
+	"gopkg.in/yaml.v3"
+)
+
+// Parse parses a swagger 2.0 or OpenAPI 3 document in JSON or YAML,
+// swagger documents are converted to OpenAPI 3.1.
+func Parse(data []byte) (*Document, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	bs, err := json.Marshal(stringKeys(v))
+	if err != nil {
+		return nil, err
+	}
+	var version struct {
+		Swagger string `json:"swagger"`
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(bs, &version); err != nil {
+		return nil, err
+	}
+	switch {
+	case version.Swagger != "":
+		swo := new(spec.Swagger)
+		if err := json.Unmarshal(bs, swo); err != nil {
+			return nil, err
+		}
+		return FromSwagger(swo), nil
+	case version.OpenAPI != "":
+		doc := new(Document)
+		if err := json.Unmarshal(bs, doc); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+	return nil, errors.New("neither swagger nor openapi version is found")
+}
+
+// stringKeys converts the keys of YAML mappings to strings, e.g. the status of responses.
+func stringKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = stringKeys(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		rst := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			rst[fmt.Sprint(k)] = stringKeys(item)
+		}
+		return rst
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringKeys(item)
+		}
+		return v
+	}
+	return v
+}
@@ -0,0 +1,48 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+func TestParse(t *testing.T) {
+	doc, err := openapi.Parse([]byte(`
+swagger: "2.0"
+x-codes:
+  1: unknown
+paths:
+  /users:
+    get:
+      x-codes:
+        1001: not found
+      responses:
+        200:
+          description: ok
+          schema:
+            $ref: "#/definitions/User"
+definitions:
+  User:
+    type: object
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Equal(t, map[string]interface{}{"1": "unknown"}, doc.Extensions[openapi.ExtCodes])
+	op := doc.Paths["/users"]["get"]
+	assert.Equal(t, map[string]interface{}{"1001": "not found"}, op.Extensions[openapi.ExtCodes])
+	assert.Equal(t, "#/components/schemas/User", op.Responses["200"].Content["application/json"].Schema.Ref.String())
+
+	bs, err := json.Marshal(doc)
+	assert.NoError(t, err)
+	parsed, err := openapi.Parse(bs)
+	assert.NoError(t, err)
+	again, err := json.Marshal(parsed)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(bs), string(again))
+
+	_, err = openapi.Parse([]byte(`{"info": {}}`))
+	assert.Error(t, err)
+}
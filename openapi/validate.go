@@ -0,0 +1,155 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/spec"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// SchemaError is a mismatch between a value and its schema.
+type SchemaError struct {
+	// Field is the path of the mismatched field, empty for the value itself.
+	Field   string
+	Message string
+}
+
+func (e SchemaError) String() string {
+	field := e.Field
+	if field == "" {
+		field = "(root)"
+	}
+	return field + ": " + e.Message
+}
+
+// Validator validates values against the schemas of a document,
+// the compiled schemas are cached.
+type Validator struct {
+	doc     *Document
+	mu      sync.Mutex
+	schemas map[*spec.Schema]*gojsonschema.Schema
+}
+
+// NewValidator returns a validator of the schemas in d.
+func (d *Document) NewValidator() *Validator {
+	return &Validator{doc: d, schemas: make(map[*spec.Schema]*gojsonschema.Schema)}
+}
+
+// Validate validates v against schema, which is a schema of the document,
+// its references are resolved in the components of document.
+// An error is returned if the schema is invalid or v can not be encoded.
+func (v *Validator) Validate(schema *spec.Schema, value interface{}) ([]SchemaError, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	s, err := v.compile(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	rst, err := s.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		return nil, err
+	}
+	errs := make([]SchemaError, 0, len(rst.Errors()))
+	for _, e := range rst.Errors() {
+		if e.Type() == "number_all_of" {
+			// the schema is wrapped in allOf to resolve references,
+			// which is reported along with the actual errors.
+			continue
+		}
+		field := e.Field()
+		if field == gojsonschema.STRING_CONTEXT_ROOT {
+			field = ""
+		}
+		errs = append(errs, SchemaError{Field: field, Message: e.Description()})
+	}
+	return errs, nil
+}
+
+func (v *Validator) compile(schema *spec.Schema) (*gojsonschema.Schema, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if s, ok := v.schemas[schema]; ok {
+		return s, nil
+	}
+	root := map[string]interface{}{"allOf": []*spec.Schema{schema}}
+	if v.doc.Components != nil {
+		root["components"] = map[string]interface{}{"schemas": v.doc.Components.Schemas}
+	}
+	s, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(root))
+	if err != nil {
+		return nil, err
+	}
+	v.schemas[schema] = s
+	return s, nil
+}
+
+// ValidateExamples checks the examples of parameters, request bodies and responses
+// against their schemas, the errors of all examples are joined.
+func (d *Document) ValidateExamples() error {
+	v := d.NewValidator()
+	var errs []error
+	paths := make([]string, 0, len(d.Paths))
+	for path := range d.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		for _, method := range methods {
+			op := d.Paths[path][method]
+			if op == nil {
+				continue
+			}
+			where := strings.ToUpper(method) + " " + path
+			for _, p := range op.Parameters {
+				if p.Example != nil {
+					errs = append(errs, v.validateExample(where+" parameter "+p.Name, p.Schema, p.Example))
+				}
+			}
+			if op.RequestBody != nil {
+				errs = append(errs, v.validateContent(where+" request body", op.RequestBody.Content)...)
+			}
+			codes := make([]string, 0, len(op.Responses))
+			for code := range op.Responses {
+				codes = append(codes, code)
+			}
+			sort.Strings(codes)
+			for _, code := range codes {
+				errs = append(errs, v.validateContent(where+" response "+code, op.Responses[code].Content)...)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (v *Validator) validateContent(where string, content map[string]MediaType) []error {
+	var errs []error
+	for mime, c := range content {
+		if c.Example != nil {
+			errs = append(errs, v.validateExample(where+" "+mime, c.Schema, c.Example))
+		}
+		for name, example := range c.Examples {
+			errs = append(errs, v.validateExample(where+" "+mime+" example "+name, c.Schema, example.Value))
+		}
+	}
+	return errs
+}
+
+func (v *Validator) validateExample(where string, schema *spec.Schema, value interface{}) error {
+	errs, err := v.Validate(schema, value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", where, err)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, e.String())
+	}
+	return fmt.Errorf("%s: %s", where, strings.Join(msgs, "; "))
+}
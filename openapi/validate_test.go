@@ -0,0 +1,33 @@
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+func TestValidator(t *testing.T) {
+	doc := &openapi.Document{Components: &openapi.Components{Schemas: map[string]spec.Schema{
+		"User": *new(spec.Schema).Typed("object", "").
+			SetProperty("id", *spec.Int64Property()).
+			WithRequired("id"),
+	}}}
+	v := doc.NewValidator()
+	schema := spec.RefSchema("#/components/schemas/User")
+	errs, err := v.Validate(schema, map[string]interface{}{"id": 1})
+	assert.NoError(t, err)
+	assert.Empty(t, errs)
+
+	errs, err = v.Validate(schema, map[string]interface{}{"id": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []openapi.SchemaError{{Field: "id", Message: "Invalid type. Expected: integer, given: string"}}, errs)
+
+	errs, err = v.Validate(schema, map[string]interface{}{})
+	assert.NoError(t, err)
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "(root): id is required", errs[0].String())
+	}
+}
@@ -0,0 +1,36 @@
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+func TestYAML(t *testing.T) {
+	bs, err := openapi.YAML(openapi.Document{
+		OpenAPI: openapi.Version,
+		Paths: map[string]openapi.PathItem{"/users": {"get": {
+			OperationID: "list",
+			Tags:        []string{"1", "true"},
+			Responses:   map[string]*openapi.Response{"200": {Description: "ok"}},
+		}}},
+		Extensions: openapi.Extensions{openapi.ExtCodes: map[string]string{"1001": "not found"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `openapi: 3.1.0
+paths:
+  /users:
+    get:
+      tags:
+        - "1"
+        - "true"
+      operationId: list
+      responses:
+        "200":
+          description: ok
+x-codes:
+  "1001": not found
+`, string(bs))
+}
@@ -0,0 +1,84 @@
+package opt_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+type benchReq struct {
+	Header struct {
+		Token string `biu:"name:X-Token"`
+	}
+	Path struct {
+		ID string
+	}
+	Query struct {
+		Page int `biu:"min:1"`
+		Tags []string
+	}
+}
+
+type benchResp struct {
+	ID   string   `json:"id"`
+	Page int      `json:"page"`
+	Tags []string `json:"tags"`
+}
+
+type benchCtl struct{}
+
+func (ctl benchCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/to/{id}"), opt.RouteTo(func(ctx box.Ctx) {
+		page, err := ctx.Query("page").Int()
+		if err == nil && page < 1 {
+			ctx.ResponseError(http.StatusBadRequest, "invalid page")
+			return
+		}
+		ctx.ResponseJSON(benchResp{
+			ID:   ctx.PathParameter("id"),
+			Page: page,
+			Tags: ctx.QueryParameters("tags"),
+		})
+	}))
+	ws.Route(ws.GET("/api/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		benchReq
+		Return func(benchResp)
+	}) {
+		api.Return(benchResp{ID: api.Path.ID, Page: api.Query.Page, Tags: api.Query.Tags})
+	}))
+	ws.Route(ws.GET("/handle/{id}"), opt.Handle(func(ctx box.Ctx, req benchReq) (benchResp, error) {
+		return benchResp{ID: req.Path.ID, Page: req.Query.Page, Tags: req.Query.Tags}, nil
+	}))
+}
+
+func benchmarkRoute(b *testing.B, path string) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "bench", Controller: benchCtl{}})
+	req := httptest.NewRequest(http.MethodGet, "/bench"+path+"/abc?page=2&tags=a&tags=b", nil)
+	req.Header.Set("X-Token", "token")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := httptest.NewRecorder()
+		c.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			b.Fatalf("unexpected status %d", w.Code)
+		}
+	}
+}
+
+func BenchmarkRouteTo(b *testing.B) {
+	benchmarkRoute(b, "/to")
+}
+
+func BenchmarkRouteAPI(b *testing.B) {
+	benchmarkRoute(b, "/api")
+}
+
+func BenchmarkHandle(b *testing.B) {
+	benchmarkRoute(b, "/handle")
+}
@@ -0,0 +1,200 @@
+package opt
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/param"
+)
+
+var errOverflow = errors.New("value out of range")
+
+// converter sets a field from the parameter p,
+// it is chosen by the field type when the route is registered.
+type converter func(field reflect.Value, ctx box.Ctx, p param.Parameter) error
+
+// newConverter returns the converter for a field of type t,
+// name is the form name of file fields.
+// It returns nil if t can not be bound.
+func newConverter(t reflect.Type, name string) converter {
+	if isTextUnmarshaler(t) || t.Kind() == reflect.Ptr && isTextUnmarshaler(t.Elem()) {
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			s, err := p.String()
+			if err != nil {
+				return err
+			}
+			v, err := unmarshalText(t, s)
+			if err != nil {
+				return err
+			}
+			field.Set(v)
+			return nil
+		}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem := newConverter(t.Elem(), name)
+		if elem == nil {
+			return nil
+		}
+		return func(field reflect.Value, ctx box.Ctx, p param.Parameter) error {
+			v := reflect.New(t.Elem())
+			if err := elem(v.Elem(), ctx, p); err != nil {
+				return err
+			}
+			field.Set(v)
+			return nil
+		}
+	case reflect.String:
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			v, err := p.String()
+			if err != nil {
+				return err
+			}
+			field.SetString(v)
+			return nil
+		}
+	case reflect.Bool:
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			v, err := p.Bool()
+			if err != nil {
+				return err
+			}
+			field.SetBool(v)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			v, err := p.Int64()
+			if err != nil {
+				return err
+			}
+			if field.OverflowInt(v) {
+				return errOverflow
+			}
+			field.SetInt(v)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			v, err := p.Uint64()
+			if err != nil {
+				return err
+			}
+			if field.OverflowUint(v) {
+				return errOverflow
+			}
+			field.SetUint(v)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			v, err := p.Float64()
+			if err != nil {
+				return err
+			}
+			if field.OverflowFloat(v) {
+				return errOverflow
+			}
+			field.SetFloat(v)
+			return nil
+		}
+	case reflect.Struct:
+		switch typeSignature(t) {
+		case "time.Time":
+			return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+				v, err := p.Time(time.RFC3339)
+				if err != nil {
+					return err
+				}
+				field.Set(reflect.ValueOf(v))
+				return nil
+			}
+		case box.FileSignature:
+			return func(field reflect.Value, ctx box.Ctx, _ param.Parameter) error {
+				f, err := ctx.FormFile(name)
+				if err != nil {
+					return err
+				}
+				field.Set(reflect.ValueOf(f))
+				return nil
+			}
+		}
+	case reflect.Array, reflect.Slice:
+		return newSliceConverter(t, name)
+	}
+	return nil
+}
+
+func newSliceConverter(t reflect.Type, name string) converter {
+	elem := t.Elem()
+	if isTextUnmarshaler(elem) || elem.Kind() == reflect.Ptr && isTextUnmarshaler(elem.Elem()) {
+		return func(field reflect.Value, _ box.Ctx, p param.Parameter) error {
+			values, err := p.StringArray()
+			if err != nil {
+				return err
+			}
+			arr := reflect.MakeSlice(reflect.SliceOf(elem), 0, len(values))
+			for _, s := range values {
+				v, err := unmarshalText(elem, s)
+				if err != nil {
+					return err
+				}
+				arr = reflect.Append(arr, v)
+			}
+			field.Set(arr)
+			return nil
+		}
+	}
+	var read func(ctx box.Ctx, p param.Parameter) (interface{}, error)
+	switch elem.Kind() {
+	case reflect.String:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.StringArray() }
+	case reflect.Bool:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.BoolArray() }
+	case reflect.Int:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.IntArray() }
+	case reflect.Int8:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Int8Array() }
+	case reflect.Int16:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Int16Array() }
+	case reflect.Int32:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Int32Array() }
+	case reflect.Int64:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Int64Array() }
+	case reflect.Uint:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.UintArray() }
+	case reflect.Uint8: // bytes
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Bytes() }
+	case reflect.Uint16:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Uint16Array() }
+	case reflect.Uint32:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Uint32Array() }
+	case reflect.Uint64:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Uint64Array() }
+	case reflect.Float32:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Float32Array() }
+	case reflect.Float64:
+		read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.Float64Array() }
+	case reflect.Struct:
+		switch typeSignature(elem) {
+		case "time.Time":
+			read = func(_ box.Ctx, p param.Parameter) (interface{}, error) { return p.TimeArray(time.RFC3339) }
+		case box.FileSignature:
+			read = func(ctx box.Ctx, _ param.Parameter) (interface{}, error) { return ctx.FormFiles(name) }
+		}
+	}
+	if read == nil {
+		return nil
+	}
+	return func(field reflect.Value, ctx box.Ctx, p param.Parameter) error {
+		rst, err := read(ctx, p)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(rst))
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package opt
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/internal"
+)
+
+// Handle binds a typed function to a route.
+// Req is a struct of parameter groups the same as the argument of RouteAPI,
+// it is bound and validated before f is called.
+// Resp is responded as the data of CommonResp, or served as a file if it is box.Download.
+// If f returns a *box.Error, its code will be responded,
+// other errors are responded with code 500.
+//
+//	ws.Route(ws.GET("/{id}"), opt.Handle(ctl.Get))
+//
+//	func (ctl Ctl) Get(ctx box.Ctx, req GetReq) (User, error)
+func Handle[Req, Resp any](f func(ctx box.Ctx, req Req) (Resp, error)) RouteFunc {
+	plan := newBindingPlan(reflect.TypeOf((*Req)(nil)).Elem())
+	params := append(plan.params[:len(plan.params):len(plan.params)], ParamOpt{
+		FieldType: FieldReturn,
+		FieldName: FieldReturn.String(),
+		Return:    new(Resp),
+		Status:    http.StatusOK,
+		Examples:  examplesOf(reflect.TypeOf((*Resp)(nil)).Elem()),
+	})
+	return func(route *Route) {
+		route.To = func(ctx box.Ctx) {
+			var req Req
+			if !plan.bind(ctx, route, reflect.ValueOf(&req).Elem()) {
+				return
+			}
+			resp, err := f(ctx, req)
+			if err != nil {
+				responseErr(ctx, err)
+				return
+			}
+			if d, ok := any(resp).(box.Download); ok {
+				ctx.ServeDownload(d)
+				return
+			}
+			ctx.ResponseJSON(resp)
+		}
+		route.ID = internal.NameOfFunction(f)
+		route.Params = params
+	}
+}
+
+// responseErr sends err through the error pipeline.
+func responseErr(ctx box.Ctx, err error) {
+	var e *box.Error
+	if errors.As(err, &e) {
+		ctx.ResponseStdErrCode(e.Code, e.Args...)
+		if e.Err != nil {
+			ctx.SetAttribute(box.BiuAttrErr, e.Err)
+		}
+		return
+	}
+	ctx.ResponseError(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+	ctx.SetAttribute(box.BiuAttrErr, err)
+}
@@ -0,0 +1,73 @@
+package opt_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type getUserReq struct {
+	Path struct {
+		ID int `biu:"min:1"`
+	}
+	Query struct {
+		Fields []string
+	}
+}
+
+type handleCtl struct{}
+
+func (ctl handleCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/users/{id}"), opt.Handle(ctl.getUser), opt.RouteErrors(map[int]string{
+		1: "user %d not found",
+	}))
+	ws.Route(ws.GET("/files/{name}"), opt.Handle(func(ctx box.Ctx, req struct {
+		Path struct {
+			Name string
+		}
+	}) (box.Download, error) {
+		return box.Download{Name: req.Path.Name, Content: strings.NewReader("content")}, nil
+	}))
+}
+
+func (ctl handleCtl) getUser(ctx box.Ctx, req getUserReq) (user, error) {
+	switch req.Path.ID {
+	case 1:
+		return user{ID: 1, Name: strings.Join(req.Query.Fields, ",")}, nil
+	case 2:
+		return user{}, box.NewError(1, errors.New("no rows"), 2)
+	default:
+		return user{}, errors.New("connection refused")
+	}
+}
+
+func TestHandle(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "handle", Controller: handleCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.GET("/handle/users/1").WithQuery("fields", "a").WithQuery("fields", "b").
+		Expect().JSON().Object().HasValue("code", 0).
+		Value("data").Object().IsEqual(user{ID: 1, Name: "a,b"})
+	e.GET("/handle/users/2").Expect().JSON().Object().
+		HasValue("code", 1).HasValue("message", "user 2 not found")
+	e.GET("/handle/users/3").Expect().JSON().Object().
+		HasValue("code", http.StatusInternalServerError).HasValue("message", "Internal Server Error")
+	e.GET("/handle/users/0").Expect().JSON().Object().HasValue("code", http.StatusBadRequest)
+	e.GET("/handle/files/a.txt").Expect().Status(http.StatusOK).Body().IsEqual("content")
+}
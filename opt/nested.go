@@ -0,0 +1,114 @@
+package opt
+
+import (
+	"net/url"
+	"reflect"
+
+	"github.com/tuotoo/biu/box"
+)
+
+const (
+	// StyleDeepObject names the fields of nested struct as filter[status].
+	StyleDeepObject = "deepObject"
+	// StyleDot names the fields of nested struct as filter.status.
+	StyleDot = "dot"
+)
+
+// paramPrefix is the name prefix of parameters in a nested struct.
+type paramPrefix struct {
+	name  string
+	style string
+}
+
+func (p paramPrefix) join(name string) string {
+	if p.name == "" {
+		return name
+	}
+	if p.style == StyleDot {
+		return p.name + "." + name
+	}
+	return p.name + "[" + name + "]"
+}
+
+// DocName returns the name of parameter in documents,
+// the keys of map parameters are shown as "key".
+func (p ParamOpt) DocName() string {
+	if !p.IsMap {
+		return p.Name
+	}
+	return paramPrefix{name: p.Name, style: p.Style}.join("key")
+}
+
+// mapKey returns the map key of a request parameter named name.
+func (p ParamOpt) mapKey(name string) (string, bool) {
+	prefix := p.Name + "["
+	suffix := "]"
+	if p.Style == StyleDot {
+		prefix, suffix = p.Name+".", ""
+	}
+	if len(name) <= len(prefix)+len(suffix) ||
+		name[:len(prefix)] != prefix || name[len(name)-len(suffix):] != suffix {
+		return "", false
+	}
+	return name[len(prefix) : len(name)-len(suffix)], true
+}
+
+// mapValues collects the parameters of a map field from request.
+func mapValues(ctx box.Ctx, p ParamOpt) map[string]string {
+	var values url.Values
+	switch p.FieldType {
+	case FieldQuery:
+		values = ctx.Req().URL.Query()
+	case FieldForm:
+		// parse the form of request
+		_, _ = ctx.BodyParameterValues(p.Name)
+		values = ctx.Req().PostForm
+	}
+	var rst map[string]string
+	for k, v := range values {
+		key, ok := p.mapKey(k)
+		if !ok || len(v) == 0 {
+			continue
+		}
+		if rst == nil {
+			rst = make(map[string]string)
+		}
+		rst[key] = v[0]
+	}
+	return rst
+}
+
+// isNestedStruct reports whether the parameters of t are read from its fields.
+func isNestedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || isTextUnmarshaler(t) {
+		return false
+	}
+	switch typeSignature(t) {
+	case "time.Time", box.FileSignature:
+		return false
+	}
+	return true
+}
+
+// isStringMap reports whether t is map[string]string.
+func isStringMap(t reflect.Type) bool {
+	return t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Elem().Kind() == reflect.String
+}
+
+// fieldByIndex returns the nested field of v by index,
+// nil pointers of nested structs will be allocated.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
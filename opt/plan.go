@@ -0,0 +1,293 @@
+package opt
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/param"
+)
+
+// bindingPlan describes how to bind the parameter groups of a request struct,
+// it is built once when the route is registered,
+// so binding a request needs no lookup of fields or tags.
+type bindingPlan struct {
+	params    []ParamOpt
+	binders   []paramBinder
+	body      *bodyBinder
+	setCookie []int
+}
+
+// paramBinder binds a parameter to its field in the request struct.
+type paramBinder struct {
+	opt     ParamOpt
+	index   []int
+	read    func(ctx box.Ctx) param.Parameter
+	convert converter
+	checked bool
+}
+
+type bodyKind int8
+
+const (
+	bodyReader bodyKind = iota
+	bodyStruct
+	bodyValue
+)
+
+// bodyBinder binds the request body to the Body field.
+type bodyBinder struct {
+	opt      ParamOpt
+	index    []int
+	kind     bodyKind
+	convert  converter
+	validate bool
+}
+
+func newBindingPlan(t reflect.Type) *bindingPlan {
+	if t.Kind() != reflect.Struct {
+		log.Fatal("request argument of route function must be a struct")
+	}
+	plan := &bindingPlan{}
+	for _, field := range []FieldType{FieldHeader, FieldCookie, FieldPath, FieldQuery, FieldForm} {
+		group, ok := t.FieldByName(field.String())
+		if !ok {
+			continue
+		}
+		n := len(plan.params)
+		plan.params = appendParam(group.Type, field, plan.params)
+		for _, p := range plan.params[n:] {
+			plan.binders = append(plan.binders, newParamBinder(p, group.Index, t))
+		}
+	}
+	if body, ok := t.FieldByName(FieldBody.String()); ok {
+		bodyType := body.Type
+		if body.Type.Kind() == reflect.Ptr {
+			bodyType = bodyType.Elem()
+		}
+		var bodyExampleValue interface{}
+		sig := typeSignature(bodyType)
+		switch sig {
+		case "io.ReadCloser", "io.Reader":
+			bodyExampleValue = ""
+		default:
+			bodyExampleValue = reflect.New(bodyType).Elem().Interface()
+		}
+		p := ParamOpt{
+			FieldType: FieldBody,
+			Body:      bodyExampleValue,
+			Desc:      body.Tag.Get(APITagDesc),
+			Examples:  examplesOf(bodyType),
+		}
+		plan.params = append(plan.params, p)
+		plan.body = newBodyBinder(p, body)
+	}
+	if setCookie, ok := t.FieldByName(SetCookieField); ok {
+		if setCookie.Type != reflect.TypeOf(func(*http.Cookie) {}) {
+			log.Fatal("SetCookie must be a func(*http.Cookie)")
+		}
+		plan.setCookie = setCookie.Index
+	}
+	return plan
+}
+
+func newParamBinder(p ParamOpt, group []int, t reflect.Type) paramBinder {
+	index := append(append([]int(nil), group...), p.Index...)
+	b := paramBinder{
+		opt:   p,
+		index: index,
+		checked: p.Required || p.Minimum != nil || p.Maximum != nil ||
+			p.MinLength != nil || p.MaxLength != nil || p.pattern != nil ||
+			len(p.Enum) > 0 || p.Validate != "",
+	}
+	if !p.IsMap {
+		b.convert = newConverter(fieldTypeByIndex(t, index), p.Name)
+	}
+	name := p.Name
+	switch p.FieldType {
+	case FieldQuery:
+		b.read = func(ctx box.Ctx) param.Parameter { return ctx.Query(name) }
+	case FieldPath:
+		b.read = func(ctx box.Ctx) param.Parameter {
+			if ctx.PathParameter(name) == "" {
+				return param.NewParameter(nil, nil)
+			}
+			return ctx.Path(name)
+		}
+	case FieldForm:
+		b.read = func(ctx box.Ctx) param.Parameter { return ctx.Form(name) }
+	case FieldHeader:
+		b.read = func(ctx box.Ctx) param.Parameter {
+			if ctx.HeaderParameter(name) == "" {
+				return param.NewParameter(nil, nil)
+			}
+			return ctx.Header(name)
+		}
+	case FieldCookie:
+		b.read = func(ctx box.Ctx) param.Parameter { return ctx.Cookie(name) }
+	}
+	return b
+}
+
+func newBodyBinder(p ParamOpt, body reflect.StructField) *bodyBinder {
+	b := &bodyBinder{opt: p, index: body.Index}
+	t := body.Type
+	switch {
+	case typeSignature(t) == "io.ReadCloser" || typeSignature(t) == "io.Reader":
+		b.kind = bodyReader
+	case t.Kind() == reflect.Struct || t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct:
+		b.kind = bodyStruct
+		b.validate = true
+	default:
+		b.kind = bodyValue
+		b.convert = newConverter(t, "")
+	}
+	return b
+}
+
+// fieldTypeByIndex returns the type of the nested field of t by index.
+func fieldTypeByIndex(t reflect.Type, index []int) reflect.Type {
+	for _, x := range index {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		t = t.Field(x).Type
+	}
+	return t
+}
+
+// bind fills the fields of sv from request,
+// it responds the error and returns false if the parameters are invalid.
+func (plan *bindingPlan) bind(ctx box.Ctx, route *Route, sv reflect.Value) bool {
+	if plan.setCookie != nil {
+		if f := sv.FieldByIndex(plan.setCookie); f.IsNil() {
+			f.Set(reflect.ValueOf(ctx.SetCookie))
+		}
+	}
+	var errs box.FieldErrors
+	for i := range plan.binders {
+		b := &plan.binders[i]
+		if err := b.bind(ctx, sv); err != nil && route.StrictBinding {
+			errs = append(errs, bindingError(b.opt, err))
+		}
+	}
+	if plan.body != nil {
+		if err := plan.body.bind(ctx, sv); err != nil && route.StrictBinding {
+			errs = append(errs, bindingError(plan.body.opt, err))
+		}
+	}
+	return plan.check(ctx, route, sv, errs)
+}
+
+// check responds the binding errors and returns false if there is any,
+// otherwise the parameters are validated.
+func (plan *bindingPlan) check(ctx box.Ctx, route *Route, sv reflect.Value, errs box.FieldErrors) bool {
+	if len(errs) > 0 {
+		code := route.BindingCode
+		if code == 0 {
+			code = http.StatusBadRequest
+		}
+		ctx.ResponseErrorData(code, errs.Error(), errs)
+		return false
+	}
+	return plan.validate(ctx, route, sv)
+}
+
+// Binding checks the request struct bound by the code generated by biugen
+// the same as RouteAPI, it is created for each request by RouteGenerated.
+type Binding struct {
+	ctx   box.Ctx
+	route *Route
+	plan  *bindingPlan
+	errs  box.FieldErrors
+}
+
+// Fail records that the parameter name in field can not be converted,
+// name is empty for Body. Missing parameters are not failures,
+// and failures are ignored without StrictBinding.
+func (b *Binding) Fail(field FieldType, name string, err error) {
+	if !b.route.StrictBinding || errors.Is(err, param.ErrParamIsEmpty) || errors.Is(err, http.ErrMissingFile) {
+		return
+	}
+	if field == FieldBody && b.plan.body != nil {
+		b.errs = append(b.errs, bindingError(b.plan.body.opt, err))
+		return
+	}
+	for i := range b.plan.binders {
+		if p := b.plan.binders[i].opt; p.FieldType == field && p.Name == name {
+			b.errs = append(b.errs, bindingError(p, err))
+			return
+		}
+	}
+	b.errs = append(b.errs, box.FieldError{Field: name, In: strings.ToLower(field.String()), Message: err.Error()})
+}
+
+// Check sets the defaults of missing parameters in api, which is a pointer to the request struct,
+// then responds the failures or validates api like RouteAPI.
+// It returns false if the request has been responded.
+func (b *Binding) Check(api interface{}) bool {
+	sv := reflect.ValueOf(api).Elem()
+	for i := range b.plan.binders {
+		p := &b.plan.binders[i]
+		if p.opt.Default == "" || p.convert == nil || len(rawValues(b.ctx, p.opt)) > 0 {
+			continue
+		}
+		_ = p.convert(fieldByIndex(sv, p.index), b.ctx, p.opt.defaultParam())
+	}
+	return b.plan.check(b.ctx, b.route, sv, b.errs)
+}
+
+// Respond implements a Return function with box.RespMeta the same as RouteAPI,
+// status is the status of its tag, which is overridden by meta.Status.
+func (b *Binding) Respond(v interface{}, status int, meta box.RespMeta) {
+	respond(b.ctx, v, status, meta)
+}
+
+func (b *paramBinder) bind(ctx box.Ctx, sv reflect.Value) error {
+	field := fieldByIndex(sv, b.index)
+	if b.opt.IsMap {
+		if m := mapValues(ctx, b.opt); m != nil {
+			field.Set(reflect.ValueOf(m).Convert(field.Type()))
+		}
+		return nil
+	}
+	if b.convert == nil {
+		return nil
+	}
+	p := b.read(ctx)
+	if b.opt.Default != "" && len(rawValues(ctx, b.opt)) == 0 {
+		p = b.opt.defaultParam()
+	}
+	err := b.convert(field, ctx, p)
+	if errors.Is(err, param.ErrParamIsEmpty) || errors.Is(err, http.ErrMissingFile) {
+		return nil
+	}
+	return err
+}
+
+func (b *bodyBinder) bind(ctx box.Ctx, sv reflect.Value) error {
+	field := sv.FieldByIndex(b.index)
+	switch b.kind {
+	case bodyReader:
+		field.Set(reflect.ValueOf(ctx.Req().Body))
+		return nil
+	case bodyStruct:
+		return ctx.Bind(field.Addr().Interface())
+	}
+	if b.convert == nil {
+		return nil
+	}
+	bodyBs, err := io.ReadAll(ctx.Req().Body)
+	if err != nil {
+		return err
+	}
+	err = b.convert(field, ctx, param.NewParameter([]string{string(bodyBs)}, nil))
+	if errors.Is(err, param.ErrParamIsEmpty) {
+		return nil
+	}
+	return err
+}
@@ -1,11 +1,15 @@
 package opt
 
 import (
+	"encoding"
 	"fmt"
-	"io"
 	"log"
+	"net/http"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -24,6 +28,31 @@ const (
 	APITagDesc   = "desc"
 	APITagFormat = "format"
 	APITagIgnore = "-"
+
+	// APITagStatus and APITagHeaders are the tags of Return functions,
+	// for documenting the status and headers of response.
+	APITagStatus  = "status"
+	APITagHeaders = "headers"
+
+	APITagRequired = "required"
+	APITagMin      = "min"
+	APITagMax      = "max"
+	// APITagPattern is a regular expression of the value.
+	// The items of biu tag are separated by ";", so values of tags
+	// can not contain it, write it as \x3b in patterns,
+	// e.g. `biu:"pattern:^a\\x3bb$"` matches "a;b".
+	APITagPattern   = "pattern"
+	APITagEnum      = "enum"
+	APITagMinLength = "minLength"
+	APITagMaxLength = "maxLength"
+	APITagDefault   = "default"
+	APITagStyle     = "style"
+	// APITagExample can not contain ";" either.
+	APITagExample = "example"
+
+	// SetCookieField is the name of func(*http.Cookie) field
+	// in the argument struct of RouteAPI, which sets a cookie in response.
+	SetCookieField = "SetCookie"
 )
 
 const (
@@ -34,6 +63,7 @@ const (
 	FieldForm
 	FieldBody
 	FieldReturn
+	FieldCookie
 )
 
 func (f FieldType) String() string {
@@ -50,6 +80,8 @@ func (f FieldType) String() string {
 		return "Body"
 	case FieldReturn:
 		return "Return"
+	case FieldCookie:
+		return "Cookie"
 	default:
 		return "Unknown"
 	}
@@ -65,6 +97,53 @@ type ParamOpt struct {
 	FieldName string
 	Body      interface{}
 	Return    interface{}
+
+	// Index is the index sequence of field in the parameter group struct.
+	Index []int
+	// IsMap means the field is a map[string]string,
+	// Style decides how its keys are named.
+	IsMap bool
+	Style string
+
+	// Status and Headers describe the response of Return.
+	Status  int
+	Headers []string
+
+	Required  bool
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int64
+	MaxLength *int64
+	Pattern   string
+	Enum      []string
+	// Validate is the tag of go-playground/validator.
+	Validate string
+	// Default is used when the parameter is absent,
+	// values of slices are separated by comma.
+	Default string
+	// Example is shown in documents, values of slices are separated by comma.
+	Example string
+	// Examples are the named examples of Body and Return from Exampler.
+	Examples map[string]interface{}
+
+	pattern *regexp.Regexp
+}
+
+// Exampler is implemented by the Body and Return types of RouteAPI,
+// its examples are shown in documents by their names.
+type Exampler interface {
+	Examples() map[string]interface{}
+}
+
+// examplesOf returns the examples of t if it or its pointer implements Exampler.
+func examplesOf(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if e, ok := reflect.New(t).Interface().(Exampler); ok {
+		return e.Examples()
+	}
+	return nil
 }
 
 // Route is the options of route.
@@ -76,6 +155,21 @@ type Route struct {
 	EnableAutoPathDoc bool
 	ExtraPathDocs     []string
 	Params            []ParamOpt
+	EventStream       bool
+	WebSocket         *WebSocketConfig
+	MaxBodySize       int64
+	MultipartLimits   box.MultipartLimits
+	ValidationCode    int
+	StrictBinding     bool
+	BindingCode       int
+	Security          []map[string][]string
+	Hidden            bool
+	NoEnvelope        bool
+	Deprecated        bool
+	DeprecatedAt      time.Time
+	Sunset            time.Time
+	Replacement       string
+	Since             string
 }
 
 // RouteID sets the ID of a route.
@@ -92,6 +186,24 @@ func RouteTo(f func(ctx box.Ctx)) RouteFunc {
 	}
 }
 
+// RouteGenerated documents a route by f the same as RouteAPI,
+// but handles requests with to, which is the static binding of f generated by biugen.
+// The request struct bound by to is checked by Binding the same as RouteAPI.
+func RouteGenerated(f interface{}, to func(ctx box.Ctx, b *Binding)) RouteFunc {
+	api := RouteAPI(f)
+	t := reflect.TypeOf(f)
+	if t.NumIn() < 2 {
+		log.Fatal("generated route function must have a request argument")
+	}
+	plan := newBindingPlan(t.In(1))
+	return func(route *Route) {
+		api(route)
+		route.To = func(ctx box.Ctx) {
+			to(ctx, &Binding{ctx: ctx, route: route, plan: plan})
+		}
+	}
+}
+
 func RouteAPI(f interface{}) RouteFunc {
 	vf := reflect.ValueOf(f)
 	if vf.Kind() != reflect.Func {
@@ -117,283 +229,190 @@ func RouteAPI(f interface{}) RouteFunc {
 	}
 
 	second := t.In(1)
-	var params []ParamOpt
-	if header, ok := second.FieldByName(FieldHeader.String()); ok {
-		params = appendParam(header.Type, FieldHeader, params)
-	}
-	if path, ok := second.FieldByName(FieldPath.String()); ok {
-		params = appendParam(path.Type, FieldPath, params)
-	}
-	if query, ok := second.FieldByName(FieldQuery.String()); ok {
-		params = appendParam(query.Type, FieldQuery, params)
+	plan := newBindingPlan(second)
+	params := plan.params[:len(plan.params):len(plan.params)]
+	var returns []ParamOpt
+	var returnIndex [][]int
+	for i := 0; i < second.NumField(); i++ {
+		if ret := second.Field(i); isReturnField(ret) {
+			returns = append(returns, returnParam(ret))
+			returnIndex = append(returnIndex, ret.Index)
+		}
 	}
-	if form, ok := second.FieldByName(FieldForm.String()); ok {
-		params = appendParam(form.Type, FieldForm, params)
+	params = append(params, returns...)
+
+	// Structs without functions are pooled, they are copied into the call
+	// and zeroed before reuse, so no value is shared between requests.
+	// Return functions and SetCookie are bound to a request,
+	// so structs with them are allocated for each request.
+	var pool *sync.Pool
+	if len(returns) == 0 && plan.setCookie == nil {
+		pool = &sync.Pool{New: func() interface{} {
+			return reflect.New(second)
+		}}
 	}
-	if body, ok := second.FieldByName(FieldBody.String()); ok {
-		bodyType := body.Type
-		if body.Type.Kind() == reflect.Ptr {
-			bodyType = bodyType.Elem()
-		}
-		var bodyExampleValue interface{}
-		sig := typeSignature(bodyType)
-		switch sig {
-		case "io.ReadCloser", "io.Reader":
-			bodyExampleValue = ""
-		default:
-			bodyExampleValue = reflect.New(bodyType).Elem().Interface()
-		}
-		params = append(params, ParamOpt{
-			FieldType: FieldBody,
-			Body:      bodyExampleValue,
-			Desc:      body.Tag.Get(APITagDesc),
-		})
-	}
-	if ret, ok := second.FieldByName(FieldReturn.String()); ok {
-		if ret.Type.Kind() != reflect.Func {
-			log.Fatal("return must be a function")
+	to := func(ctx box.Ctx, route *Route) {
+		var sv reflect.Value
+		if pool != nil {
+			ptr := pool.Get().(reflect.Value)
+			defer func() {
+				ptr.Elem().SetZero()
+				pool.Put(ptr)
+			}()
+			sv = ptr.Elem()
+		} else {
+			sv = reflect.New(second).Elem()
 		}
-		if ret.Type.NumIn() < 1 {
-			log.Fatal("return must at least has an argument")
+		for i, v := range returns {
+			field := sv.FieldByIndex(returnIndex[i])
+			field.Set(reflect.MakeFunc(field.Type(), returnFunc(ctx, v.Status)))
 		}
-		params = append(params, ParamOpt{
-			FieldType: FieldReturn,
-			Return:    reflect.New(ret.Type.In(0)).Interface(),
-			Desc:      ret.Tag.Get(APITagDesc),
-		})
-	}
-
-	to := func(ctx box.Ctx) {
-		sv := reflect.New(second).Elem()
-		for _, v := range params {
-			switch v.FieldType {
-			case FieldBody:
-				bodyType := sv.FieldByName(FieldBody.String()).Type()
-				switch typeSignature(bodyType) {
-				case "io.ReadCloser", "io.Reader":
-					sv.FieldByName(FieldBody.String()).Set(reflect.ValueOf(ctx.Req().Body))
-					continue
-				}
-				if bodyType.Kind() != reflect.Struct && !(bodyType.Kind() == reflect.Ptr && bodyType.Elem().Kind() == reflect.Struct) {
-					setField(sv, ctx, v)
-					continue
-				}
-				body := reflect.New(bodyType).Interface()
-				_ = ctx.Bind(body)
-				sv.FieldByName(FieldBody.String()).Set(reflect.ValueOf(body).Elem())
-			case FieldReturn:
-				sv.FieldByName(FieldReturn.String()).Set(reflect.MakeFunc(sv.FieldByName(FieldReturn.String()).Type(),
-					func(args []reflect.Value) (results []reflect.Value) {
-						if len(args) < 1 {
-							return nil
-						}
-						ctx.ResponseJSON(args[0].Interface())
-						return nil
-					}))
-			default:
-				setField(sv, ctx, v)
-			}
+		if !plan.bind(ctx, route, sv) {
+			return
 		}
 		vf.Call([]reflect.Value{reflect.ValueOf(ctx), sv})
 	}
 	return func(route *Route) {
-		route.To = to
+		route.To = func(ctx box.Ctx) {
+			to(ctx, route)
+		}
 		route.ID = internal.NameOfFunction(f)
 		route.Params = params
 	}
 }
 
-func setField(sv reflect.Value, ctx box.Ctx, opt ParamOpt) {
-	var field reflect.Value
-	var p param.Parameter
-	switch opt.FieldType {
-	case FieldQuery:
-		p = ctx.Query(opt.Name)
-		field = sv.FieldByName(opt.FieldType.String()).FieldByName(opt.FieldName)
-	case FieldPath:
-		p = ctx.Path(opt.Name)
-		field = sv.FieldByName(opt.FieldType.String()).FieldByName(opt.FieldName)
-	case FieldForm:
-		p = ctx.Form(opt.Name)
-		field = sv.FieldByName(opt.FieldType.String()).FieldByName(opt.FieldName)
-	case FieldHeader:
-		p = ctx.Header(opt.Name)
-		field = sv.FieldByName(opt.FieldType.String()).FieldByName(opt.FieldName)
-	case FieldBody:
-		bodyBs, _ := io.ReadAll(ctx.Req().Body)
-		p = param.NewParameter([]string{string(bodyBs)}, nil)
-		field = sv.FieldByName(opt.FieldType.String())
-	default:
-		return
-	}
-	switch field.Kind() {
-	case reflect.Ptr:
-		setPtr(field, p)
-	case reflect.String:
-		field.SetString(p.StringDefault(""))
-	case reflect.Bool:
-		field.SetBool(p.BoolDefault(false))
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		field.SetInt(p.Int64Default(0))
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		field.SetUint(p.Uint64Default(0))
-	case reflect.Float32, reflect.Float64:
-		field.SetFloat(p.Float64Default(0))
-	case reflect.Struct:
-		switch typeSignature(field.Type()) {
-		case "time.Time":
-			field.Set(reflect.ValueOf(p.TimeDefault(time.RFC3339, time.Time{})))
-		case box.FileSignature:
-			f, fh, _ := ctx.Req().FormFile(opt.Name)
-			field.Set(reflect.ValueOf(box.File{
-				Header: fh,
-				File:   f,
-			}))
-		default:
-			return
+// returnFunc returns the implementation of a Return function,
+// status is the default status of the response.
+func returnFunc(ctx box.Ctx, status int) func(args []reflect.Value) []reflect.Value {
+	return func(args []reflect.Value) []reflect.Value {
+		if len(args) < 1 {
+			return nil
 		}
-	case reflect.Array, reflect.Slice:
-		var rst interface{}
-		elem := field.Type().Elem()
-		switch elem.Kind() {
-		case reflect.String:
-			rst, _ = p.StringArray()
-		case reflect.Bool:
-			rst, _ = p.BoolArray()
-		case reflect.Int:
-			rst, _ = p.IntArray()
-		case reflect.Int8:
-			rst, _ = p.Int8Array()
-		case reflect.Int16:
-			rst, _ = p.Int16Array()
-		case reflect.Int32:
-			rst, _ = p.Int32Array()
-		case reflect.Int64:
-			rst, _ = p.Int64Array()
-		case reflect.Uint:
-			rst, _ = p.UintArray()
-		case reflect.Uint8: // bytes
-			rst, _ = p.Bytes()
-		case reflect.Uint16:
-			rst, _ = p.Uint16Array()
-		case reflect.Uint32:
-			rst, _ = p.Uint32Array()
-		case reflect.Uint64:
-			rst, _ = p.Uint64Array()
-		case reflect.Float32:
-			rst, _ = p.Float32Array()
-		case reflect.Float64:
-			rst, _ = p.Float64Array()
-		case reflect.Struct:
-			switch typeSignature(elem) {
-			case "time.Time":
-				rst, _ = p.TimeArray(time.RFC3339)
-			default:
-				return
-			}
+		if d, ok := args[0].Interface().(box.Download); ok {
+			ctx.ServeDownload(d)
+			return nil
 		}
-		field.Set(reflect.ValueOf(rst))
-	default:
-		return
+		var meta box.RespMeta
+		if len(args) > 1 {
+			meta = args[1].Interface().(box.RespMeta)
+		}
+		respond(ctx, args[0].Interface(), status, meta)
+		return nil
 	}
 }
 
-func setPtr(field reflect.Value, p param.Parameter) {
-	switch field.Type().Elem().Kind() {
-	case reflect.String:
-		v, err := p.String()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Bool:
-		v, err := p.Bool()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Int:
-		v, err := p.Int()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Int8:
-		v, err := p.Int8()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Int16:
-		v, err := p.Int16()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Int32:
-		v, err := p.Int32()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Int64:
-		v, err := p.Int64()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Uint:
-		v, err := p.Uint()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Uint8:
-		v, err := p.Uint8()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Uint16:
-		v, err := p.Uint16()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Uint32:
-		v, err := p.Uint32()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Uint64:
-		v, err := p.Uint64()
-		if err != nil {
-			return
+// respond responds v with the headers of meta,
+// and the status of meta if it is set, otherwise status.
+func respond(ctx box.Ctx, v interface{}, status int, meta box.RespMeta) {
+	for k, values := range meta.Header {
+		for _, value := range values {
+			ctx.Resp().Header().Add(k, value)
 		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Float32:
-		v, err := p.Float32()
-		if err != nil {
-			return
-		}
-		field.Set(reflect.ValueOf(&v))
-	case reflect.Float64:
-		v, err := p.Float64()
+	}
+	if meta.Status != 0 {
+		status = meta.Status
+	}
+	if status != http.StatusOK {
+		ctx.SetStatus(status)
+	}
+	ctx.ResponseJSON(v)
+}
+
+// defaultParam returns the default value as a parameter.
+func (opt ParamOpt) defaultParam() param.Parameter {
+	if opt.IsMulti && opt.Format != "byte" {
+		return param.NewParameter(strings.Split(opt.Default, ","), nil)
+	}
+	return param.NewParameter([]string{opt.Default}, nil)
+}
+
+// exampleParam returns the example value as a parameter.
+func (opt ParamOpt) exampleParam() param.Parameter {
+	if opt.IsMulti && opt.Format != "byte" {
+		return param.NewParameter(strings.Split(opt.Example, ","), nil)
+	}
+	return param.NewParameter([]string{opt.Example}, nil)
+}
+
+// isReturnField reports whether f is Return or a named return function like ReturnCreated.
+func isReturnField(f reflect.StructField) bool {
+	name := FieldReturn.String()
+	if f.Name == name {
+		return true
+	}
+	if !strings.HasPrefix(f.Name, name) || f.Type.Kind() != reflect.Func {
+		return false
+	}
+	return unicode.IsUpper([]rune(f.Name[len(name):])[0])
+}
+
+func returnParam(ret reflect.StructField) ParamOpt {
+	if ret.Type.Kind() != reflect.Func {
+		log.Fatal("return must be a function")
+	}
+	if ret.Type.NumIn() < 1 {
+		log.Fatal("return must at least has an argument")
+	}
+	if ret.Type.NumIn() > 1 && typeSignature(ret.Type.In(1)) != box.RespMetaSignature {
+		log.Fatal("the second argument of return must be box.RespMeta")
+	}
+	if ret.Type.NumIn() > 1 && typeSignature(ret.Type.In(0)) == box.DownloadSignature {
+		log.Fatal("return of box.Download can not have box.RespMeta, set the headers of Download instead")
+	}
+	status := http.StatusOK
+	if tag, ok := ret.Tag.Lookup(APITagStatus); ok {
+		var err error
+		status, err = strconv.Atoi(tag)
 		if err != nil {
-			return
+			log.Fatalf("invalid status of %s: %v", ret.Name, err)
 		}
-		field.Set(reflect.ValueOf(&v))
+	}
+	var headers []string
+	if tag := ret.Tag.Get(APITagHeaders); tag != "" {
+		headers = strings.Split(tag, ",")
+	}
+	return ParamOpt{
+		FieldType: FieldReturn,
+		FieldName: ret.Name,
+		Return:    reflect.New(ret.Type.In(0)).Interface(),
+		Desc:      ret.Tag.Get(APITagDesc),
+		Status:    status,
+		Headers:   headers,
+		Examples:  examplesOf(ret.Type.In(0)),
 	}
 }
 
+// bindingError describes a parameter which can not be converted to the field type.
+func bindingError(opt ParamOpt, err error) box.FieldError {
+	in := strings.ToLower(opt.FieldType.String())
+	if opt.FieldType == FieldBody && opt.Type == "" {
+		return box.FieldError{In: in, Message: "cannot be decoded: " + err.Error()}
+	}
+	typ := opt.Type
+	if opt.Format != "" {
+		typ += "(" + opt.Format + ")"
+	}
+	if opt.IsMulti {
+		typ = "array of " + typ
+	}
+	return box.FieldError{Field: opt.Name, In: in, Message: "expected " + typ}
+}
+
 func appendParam(t reflect.Type, field FieldType, params []ParamOpt) []ParamOpt {
+	return appendStructParam(t, field, paramPrefix{}, nil, params)
+}
+
+// appendStructParam appends the parameters of struct t,
+// fields of anonymous embedded structs are flattened,
+// and fields of named nested structs are prefixed by the name of struct.
+func appendStructParam(t reflect.Type, field FieldType, prefix paramPrefix, index []int, params []ParamOpt) []ParamOpt {
 	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
 		tags := make(map[string]string)
-		if cfg, ok := t.Field(i).Tag.Lookup("biu"); ok {
+		if cfg, ok := sf.Tag.Lookup("biu"); ok {
 			items := strings.Split(cfg, ";")
 			for _, item := range items {
-				sp := strings.Split(item, ":")
+				sp := strings.SplitN(item, ":", 2)
 				if len(sp) > 1 {
 					tags[sp[0]] = sp[1]
 				} else {
@@ -404,15 +423,66 @@ func appendParam(t reflect.Type, field FieldType, params []ParamOpt) []ParamOpt
 		if _, ok := tags[APITagIgnore]; ok {
 			continue
 		}
-		fieldName := t.Field(i).Name
+		fieldIndex := append(append([]int(nil), index...), i)
+		fieldName := sf.Name
 		name := internal.CamelToSnake(fieldName)
-		if unicode.IsLower([]rune(fieldName)[0]) {
+		tagName, hasName := tags[APITagName]
+		if hasName {
+			name = tagName
+		}
+		exported := !unicode.IsLower([]rune(fieldName)[0])
+		if isNestedStruct(sf.Type) {
+			structType := sf.Type
+			if structType.Kind() == reflect.Ptr {
+				if !exported {
+					continue
+				}
+				structType = structType.Elem()
+			}
+			if sf.Anonymous && !hasName {
+				params = appendStructParam(structType, field, prefix, fieldIndex, params)
+				continue
+			}
+			if !exported {
+				continue
+			}
+			nested := paramPrefix{name: prefix.join(name), style: prefix.style}
+			if style, ok := tags[APITagStyle]; ok {
+				nested.style = style
+			}
+			params = appendStructParam(structType, field, nested, fieldIndex, params)
 			continue
 		}
-		if tagName, ok := tags[APITagName]; ok {
-			name = tagName
+		if !exported {
+			continue
 		}
-		typ, err := getBaseType(t.Field(i).Type)
+		if isStringMap(sf.Type) {
+			if field != FieldQuery && field != FieldForm {
+				log.Printf("map field %s is only supported in Query and Form", fieldName)
+				continue
+			}
+			if _, ok := tags[APITagExample]; ok {
+				log.Fatalf("map field %s can not have example", fieldName)
+			}
+			style := prefix.style
+			if v, ok := tags[APITagStyle]; ok {
+				style = v
+			}
+			p := ParamOpt{
+				FieldType: field,
+				Name:      prefix.join(name),
+				Type:      "string",
+				FieldName: fieldName,
+				Desc:      tags[APITagDesc],
+				Index:     fieldIndex,
+				IsMap:     true,
+				Style:     style,
+			}
+			p.parseConstraints(tags, sf.Tag.Get("validate"))
+			params = append(params, p)
+			continue
+		}
+		typ, err := getBaseType(sf.Type)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -420,15 +490,38 @@ func appendParam(t reflect.Type, field FieldType, params []ParamOpt) []ParamOpt
 		if tagFormat, ok := tags[APITagFormat]; ok {
 			typ.format = tagFormat
 		}
-		params = append(params, ParamOpt{
+		p := ParamOpt{
 			FieldType: field,
-			Name:      name,
+			Name:      prefix.join(name),
 			Type:      typ.typ,
 			Format:    typ.format,
 			IsMulti:   typ.multi,
 			FieldName: fieldName,
 			Desc:      tags[APITagDesc],
-		})
+			Index:     fieldIndex,
+		}
+		p.parseConstraints(tags, sf.Tag.Get("validate"))
+		if v, ok := tags[APITagDefault]; ok {
+			p.Default = v
+			if typ.typ == "file" {
+				log.Fatalf("file field %s can not have default value", fieldName)
+			}
+			err := newConverter(sf.Type, p.Name)(reflect.New(sf.Type).Elem(), box.Ctx{}, p.defaultParam())
+			if err != nil {
+				log.Fatalf("invalid default of field %s: %v", fieldName, err)
+			}
+		}
+		if v, ok := tags[APITagExample]; ok {
+			p.Example = v
+			if typ.typ == "file" {
+				log.Fatalf("file field %s can not have example", fieldName)
+			}
+			err := newConverter(sf.Type, p.Name)(reflect.New(sf.Type).Elem(), box.Ctx{}, p.exampleParam())
+			if err != nil {
+				log.Fatalf("invalid example of field %s: %v", fieldName, err)
+			}
+		}
+		params = append(params, p)
 	}
 	return params
 }
@@ -440,6 +533,63 @@ func EnableAuth() RouteFunc {
 	}
 }
 
+// Hidden excludes the route from documents, it is still served.
+func Hidden() RouteFunc {
+	return func(route *Route) {
+		route.Hidden = true
+	}
+}
+
+// NoEnvelope responds the successful responses of route as they are,
+// instead of wrapping them in box.CommonResp by DefaultResponseTransformer.
+// The errors are still responded by DefaultErrorTransformer.
+func NoEnvelope() RouteFunc {
+	return func(route *Route) {
+		route.NoEnvelope = true
+	}
+}
+
+// Deprecated marks the route as deprecated in documents,
+// and responds it with the Deprecation, Sunset and Link headers.
+// sunset is the time the route will be removed,
+// replacement is the URL of its successor, they are omitted if zero.
+func Deprecated(sunset time.Time, replacement string) RouteFunc {
+	return func(route *Route) {
+		route.Deprecated = true
+		route.Sunset = sunset
+		route.Replacement = replacement
+	}
+}
+
+// DeprecatedAt marks the route as deprecated since date,
+// which is responded in the Deprecation header.
+// The header is omitted for routes deprecated without a date.
+func DeprecatedAt(date time.Time) RouteFunc {
+	return func(route *Route) {
+		route.Deprecated = true
+		route.DeprecatedAt = date
+	}
+}
+
+// Since documents the version in which the route is added.
+func Since(version string) RouteFunc {
+	return func(route *Route) {
+		route.Since = version
+	}
+}
+
+// Security documents the route is secured by a security scheme of SwaggerInfo,
+// scopes are the required scopes of OAuth2 schemes.
+// The schemes of multiple calls are alternatives.
+func Security(name string, scopes ...string) RouteFunc {
+	return func(route *Route) {
+		if scopes == nil {
+			scopes = []string{}
+		}
+		route.Security = append(route.Security, map[string][]string{name: scopes})
+	}
+}
+
 // RouteErrors defines the errors of a route.
 func RouteErrors(m map[int]string) RouteFunc {
 	return func(route *Route) {
@@ -461,6 +611,78 @@ func ExtraPathDocs(docs ...string) RouteFunc {
 	}
 }
 
+// StrictBinding makes RouteAPI respond the code before calling the handler
+// when any parameter can not be converted to its field type,
+// instead of leaving the field as zero value. The default code is 400.
+func StrictBinding(code int) RouteFunc {
+	return func(route *Route) {
+		route.StrictBinding = true
+		route.BindingCode = code
+	}
+}
+
+// MaxBodySize limits the size in bytes of the request body.
+func MaxBodySize(n int64) RouteFunc {
+	return func(route *Route) {
+		route.MaxBodySize = n
+	}
+}
+
+// MultipartMemory sets the max bytes of file parts stored in memory
+// when parsing multipart form, the rest will be stored in temporary files.
+func MultipartMemory(n int64) RouteFunc {
+	return func(route *Route) {
+		route.MultipartLimits.MaxMemory = n
+	}
+}
+
+// MaxPartSize limits the size in bytes of each part of multipart form.
+func MaxPartSize(n int64) RouteFunc {
+	return func(route *Route) {
+		route.MultipartLimits.MaxPartSize = n
+	}
+}
+
+// EventStream declares the route responds with Server-Sent Events.
+func EventStream() RouteFunc {
+	return func(route *Route) {
+		route.EventStream = true
+	}
+}
+
+// SwaggerTyper can be implemented by the types implement encoding.TextUnmarshaler
+// to describe their type and format in swagger, the default type is string.
+type SwaggerTyper interface {
+	SwaggerType() (typ, format string)
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	swaggerTyperType    = reflect.TypeOf((*SwaggerTyper)(nil)).Elem()
+)
+
+// isTextUnmarshaler reports whether the parameter of type t
+// should be bound by encoding.TextUnmarshaler.
+func isTextUnmarshaler(t reflect.Type) bool {
+	switch typeSignature(t) {
+	case "time.Time", box.FileSignature:
+		return false
+	}
+	return reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// unmarshalText creates a value of t from s,
+// t or the elem of t must be a TextUnmarshaler.
+func unmarshalText(t reflect.Type, s string) (reflect.Value, error) {
+	if t.Kind() == reflect.Ptr && !isTextUnmarshaler(t) {
+		v := reflect.New(t.Elem())
+		return v, v.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	v := reflect.New(t)
+	err := v.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	return v.Elem(), err
+}
+
 type baseType struct {
 	typ    string
 	format string
@@ -471,6 +693,13 @@ func getBaseType(t reflect.Type) (*baseType, error) {
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
+	if isTextUnmarshaler(t) {
+		if typer, ok := reflect.New(t).Interface().(SwaggerTyper); ok {
+			typ, format := typer.SwaggerType()
+			return &baseType{typ: typ, format: format}, nil
+		}
+		return &baseType{typ: "string"}, nil
+	}
 	var typ, format string
 	switch t.Kind() {
 	case reflect.String:
@@ -2,18 +2,22 @@ package opt_test
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/emicklei/go-restful/v3"
+	"github.com/gavv/httpexpect/v2"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/tuotoo/biu"
 	"github.com/tuotoo/biu/box"
 	"github.com/tuotoo/biu/opt"
 )
@@ -53,12 +57,54 @@ func TestRouteErrors(t *testing.T) {
 	assert.Equal(t, "2", cfg.Errors[1])
 }
 
+func TestSecurity(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.Security("basic")(cfg)
+	opt.Security("oauth", "read", "write")(cfg)
+	assert.Equal(t, []map[string][]string{
+		{"basic": {}},
+		{"oauth": {"read", "write"}},
+	}, cfg.Security)
+}
+
 func TestRouteID(t *testing.T) {
 	cfg := &opt.Route{}
 	opt.RouteID("routeID")(cfg)
 	assert.Equal(t, "routeID", cfg.ID)
 }
 
+type pagination struct {
+	Page int
+	Size int
+}
+
+type Cursor struct {
+	After string
+}
+
+type level int
+
+const (
+	levelLow level = iota + 1
+	levelHigh
+)
+
+func (l *level) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "low":
+		*l = levelLow
+	case "high":
+		*l = levelHigh
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func (l level) SwaggerType() (typ, format string) {
+	return "string", "level"
+}
+
 func TestRouteAPI(t *testing.T) {
 	for _, v := range []struct {
 		req     func() *restful.Request
@@ -429,6 +475,112 @@ func TestRouteAPI(t *testing.T) {
 				assert.Equal(t, "123", string(bs))
 			},
 		},
+		{
+			req: func() *restful.Request {
+				var b bytes.Buffer
+				w := multipart.NewWriter(&b)
+				for _, name := range []string{"f1", "f2"} {
+					fw, err := w.CreateFormFile("files", name)
+					assert.NoError(t, err)
+					_, err = fw.Write([]byte(name))
+					assert.NoError(t, err)
+				}
+				assert.NoError(t, w.Close())
+
+				req := restful.NewRequest(httptest.NewRequest(http.MethodPost, "/", &b))
+				req.Request.Header.Set("Content-Type", w.FormDataContentType())
+				return req
+			},
+			routAPI: func(ctx box.Ctx, api struct {
+				Form struct {
+					Files []box.File
+				}
+			}) {
+				assert.Len(t, api.Form.Files, 2)
+				for i, f := range api.Form.Files {
+					bs, err := io.ReadAll(f)
+					assert.NoError(t, err)
+					assert.Equal(t, []string{"f1", "f2"}[i], string(bs))
+					assert.Equal(t, []string{"f1", "f2"}[i], f.Header.Filename)
+				}
+			},
+		},
+		{
+			req: func() *restful.Request {
+				return restful.NewRequest(httptest.NewRequest(http.MethodGet, "/?limit=3&ids=", nil))
+			},
+			routAPI: func(ctx box.Ctx, api struct {
+				Header struct {
+					Lang string `biu:"name:Accept-Language;default:en"`
+				}
+				Query struct {
+					Limit int       `biu:"default:20"`
+					Page  *int      `biu:"default:1"`
+					Tags  []string  `biu:"default:a,b"`
+					IDs   []int     `biu:"name:ids;default:1,2"`
+					Since time.Time `biu:"default:2006-01-02T15:04:05Z"`
+				}
+			}) {
+				assert.Equal(t, "en", api.Header.Lang)
+				assert.Equal(t, 3, api.Query.Limit)
+				assert.Equal(t, 1, *api.Query.Page)
+				assert.Equal(t, []string{"a", "b"}, api.Query.Tags)
+				assert.Equal(t, []int{1, 2}, api.Query.IDs)
+				assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), api.Query.Since)
+			},
+		},
+		{
+			req: func() *restful.Request {
+				return restful.NewRequest(httptest.NewRequest(http.MethodGet, "/?level=high&levels=low&levels=high&p=low", nil))
+			},
+			routAPI: func(ctx box.Ctx, api struct {
+				Query struct {
+					Level   level
+					Levels  []level
+					P       *level
+					Default level `biu:"default:high"`
+				}
+			}) {
+				assert.Equal(t, levelHigh, api.Query.Level)
+				assert.Equal(t, []level{levelLow, levelHigh}, api.Query.Levels)
+				assert.Equal(t, levelLow, *api.Query.P)
+				assert.Equal(t, levelHigh, api.Query.Default)
+			},
+		},
+		{
+			req: func() *restful.Request {
+				return restful.NewRequest(httptest.NewRequest(http.MethodGet,
+					"/?page=2&size=5&filter[status]=open&filter[owner][id]=7&sort.by=name&meta[a]=1&meta[b]=2&opt.x=y", nil))
+			},
+			routAPI: func(ctx box.Ctx, api struct {
+				Query struct {
+					pagination
+					*Cursor
+					Filter struct {
+						Status string
+						Owner  *struct {
+							ID int
+						}
+					}
+					Sort struct {
+						By string
+					} `biu:"style:dot"`
+					Meta map[string]string
+					Opt  map[string]string `biu:"style:dot"`
+					None map[string]string
+				}
+			}) {
+				assert.Equal(t, 2, api.Query.Page)
+				assert.Equal(t, 5, api.Query.Size)
+				assert.NotNil(t, api.Query.Cursor)
+				assert.Equal(t, "open", api.Query.Filter.Status)
+				assert.Equal(t, 7, api.Query.Filter.Owner.ID)
+				assert.Equal(t, "name", api.Query.Sort.By)
+				assert.Equal(t, map[string]string{"a": "1", "b": "2"}, api.Query.Meta)
+				assert.Equal(t, map[string]string{"x": "y"}, api.Query.Opt)
+				assert.Nil(t, api.Query.None)
+			},
+		},
 	} {
 		cfg := &opt.Route{}
 		opt.RouteAPI(v.routAPI)(cfg)
@@ -437,3 +589,229 @@ func TestRouteAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestEventStream(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.EventStream()(cfg)
+	assert.Equal(t, true, cfg.EventStream)
+}
+
+func TestMultipartLimits(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.MaxBodySize(1)(cfg)
+	opt.MultipartMemory(2)(cfg)
+	opt.MaxPartSize(3)(cfg)
+	assert.Equal(t, int64(1), cfg.MaxBodySize)
+	assert.Equal(t, box.MultipartLimits{MaxMemory: 2, MaxPartSize: 3}, cfg.MultipartLimits)
+}
+
+type strictCtl struct{}
+
+func (ctl strictCtl) WebService(ws biu.WS) {
+	ws.Route(ws.POST("/{id}").Consumes(restful.MIME_JSON), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			ID int
+		}
+		Query struct {
+			Page  int
+			Size  *int8
+			Since time.Time
+			IDs   []int `biu:"name:ids"`
+			Level level
+		}
+		Header struct {
+			Limit uint `biu:"name:X-Limit"`
+		}
+		Body struct {
+			Name string `json:"name"`
+		}
+	}) {
+		ctx.ResponseJSON(api.Query.Page)
+	}))
+	ws.Route(ws.GET("/page"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			Page int
+		}
+	}) {
+		ctx.ResponseJSON(api.Query.Page)
+	}))
+}
+
+func TestStrictBinding(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.StrictBinding(1)(cfg)
+	assert.True(t, cfg.StrictBinding)
+	assert.Equal(t, 1, cfg.BindingCode)
+
+	c := biu.New()
+	c.RouteDefaults(opt.StrictBinding(1002))
+	c.AddServices("", nil, biu.NS{NameSpace: "strict", Controller: strictCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.POST("/strict/1").WithQuery("page", 2).WithQuery("size", 3).WithQuery("ids", 1).
+		WithQuery("since", "2006-01-02T15:04:05Z").WithHeader("X-Limit", "5").WithJSON(map[string]string{"name": "a"}).
+		Expect().JSON().Object().HasValue("code", 0).HasValue("data", 2)
+	e.POST("/strict/1").WithJSON(map[string]string{}).
+		Expect().JSON().Object().HasValue("code", 0).HasValue("data", 0)
+
+	resp := e.POST("/strict/a").WithQuery("page", "abc").WithQuery("size", 300).
+		WithQuery("since", "yesterday").WithQuery("ids", 1).WithQuery("ids", "x").WithQuery("level", "mid").
+		WithHeader("X-Limit", "ten").WithHeader("Content-Type", restful.MIME_JSON).WithText("{").
+		Expect().JSON().Object()
+	resp.HasValue("code", 1002)
+	resp.Value("message").String().IsEqual(`header.X-Limit: expected integer; path.id: expected integer; ` +
+		`query.page: expected integer; query.size: expected integer; query.since: expected string(date-time); ` +
+		`query.ids: expected array of integer; query.level: expected string(level); body: cannot be decoded: unexpected EOF`)
+	resp.Value("data").Array().Length().IsEqual(8)
+
+	e.GET("/strict/page").WithQuery("page", "abc").
+		Expect().JSON().Object().HasValue("code", 1002)
+}
+
+type cookieCtl struct{}
+
+func (ctl cookieCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Cookie struct {
+			Session string `biu:"required"`
+			Theme   string `biu:"default:light"`
+			Visits  int
+		}
+		SetCookie func(*http.Cookie)
+		Return    func(string)
+	}) {
+		api.SetCookie(&http.Cookie{Name: "visits", Value: strconv.Itoa(api.Cookie.Visits + 1)})
+		api.Return(api.Cookie.Session + "," + api.Cookie.Theme)
+	}))
+}
+
+func TestCookie(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "cookie", Controller: cookieCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	resp := e.GET("/cookie").WithCookie("session", "s1").WithCookie("visits", "2").Expect()
+	resp.JSON().Object().HasValue("code", 0).HasValue("data", "s1,light")
+	resp.Cookie("visits").Value().IsEqual("3")
+
+	e.GET("/cookie").Expect().JSON().Object().HasValue("code", http.StatusBadRequest).
+		Value("data").Array().IsEqual([]box.FieldError{{Field: "session", In: "cookie", Message: "is required"}})
+}
+
+type returnCtl struct{}
+
+func (ctl returnCtl) WebService(ws biu.WS) {
+	ws.Route(ws.POST("/{mode}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			Mode string
+		}
+		Return         func(string, box.RespMeta) `status:"201" headers:"Location"`
+		ReturnAccepted func(string)               `status:"202"`
+		ReturnDeleted  func(*struct{})            `status:"204"`
+	}) {
+		switch api.Path.Mode {
+		case "created":
+			api.Return("1", box.RespMeta{Header: http.Header{"Location": {"/items/1"}}})
+		case "ok":
+			api.Return("2", box.RespMeta{Status: http.StatusOK})
+		case "accepted":
+			api.ReturnAccepted("3")
+		default:
+			api.ReturnDeleted(nil)
+		}
+	}))
+}
+
+func TestReturnStatus(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "return", Controller: returnCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	resp := e.POST("/return/created").Expect().Status(http.StatusCreated)
+	resp.Header("Location").IsEqual("/items/1")
+	resp.JSON().Object().HasValue("data", "1")
+	e.POST("/return/ok").Expect().Status(http.StatusOK).JSON().Object().HasValue("data", "2")
+	e.POST("/return/accepted").Expect().Status(http.StatusAccepted).JSON().Object().HasValue("data", "3")
+	e.POST("/return/deleted").Expect().Status(http.StatusNoContent).NoContent()
+}
+
+type pooledCtl struct{}
+
+func (ctl pooledCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			Name  string
+			Tags  []string
+			Level *int
+		}
+	}) {
+		ctx.ResponseJSON(api.Query)
+	}))
+}
+
+func TestRouteAPIPooled(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "pooled", Controller: pooledCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.GET("/pooled").WithQuery("name", "a").WithQuery("tags", "x").WithQuery("level", 1).
+		Expect().JSON().Object().Value("data").Object().
+		HasValue("Name", "a").HasValue("Tags", []string{"x"}).HasValue("Level", 1)
+	for i := 0; i < 10; i++ {
+		e.GET("/pooled").Expect().JSON().Object().Value("data").Object().
+			HasValue("Name", "").HasValue("Tags", nil).HasValue("Level", nil)
+	}
+}
+
+type exampleBody struct {
+	Name string `json:"name"`
+}
+
+func (exampleBody) Examples() map[string]interface{} {
+	return map[string]interface{}{"tom": exampleBody{Name: "tom"}}
+}
+
+func TestExamples(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			IDs []int `biu:"name:ids;example:1,2"`
+		}
+		Body   exampleBody
+		Return func(*exampleBody)
+	}) {
+	})(cfg)
+	examples := make(map[opt.FieldType]opt.ParamOpt)
+	for _, p := range cfg.Params {
+		examples[p.FieldType] = p
+	}
+	assert.Equal(t, "1,2", examples[opt.FieldQuery].Example)
+	assert.Equal(t, exampleBody{}.Examples(), examples[opt.FieldBody].Examples)
+	assert.Equal(t, exampleBody{}.Examples(), examples[opt.FieldReturn].Examples)
+}
+
+func TestDeprecated(t *testing.T) {
+	cfg := &opt.Route{}
+	date := time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	opt.Deprecated(sunset, "/v2/users")(cfg)
+	assert.True(t, cfg.Deprecated)
+	assert.True(t, cfg.DeprecatedAt.IsZero())
+	opt.DeprecatedAt(date)(cfg)
+	opt.Since("v1.2")(cfg)
+	opt.Hidden()(cfg)
+	assert.True(t, cfg.Deprecated)
+	assert.Equal(t, date, cfg.DeprecatedAt)
+	assert.Equal(t, sunset, cfg.Sunset)
+	assert.Equal(t, "/v2/users", cfg.Replacement)
+	assert.Equal(t, "v1.2", cfg.Since)
+	assert.True(t, cfg.Hidden)
+}
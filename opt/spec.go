@@ -0,0 +1,31 @@
+package opt
+
+// SpecValidationFunc is the type of spec validation config functions.
+type SpecValidationFunc func(*SpecValidation)
+
+// SpecValidation is the options of validating requests and responses
+// against the OpenAPI document of container.
+type SpecValidation struct {
+	// Code is the error code responded on violations,
+	// they are only logged if it is 0.
+	Code int
+	// Responses enables validating the response bodies,
+	// which is meant for tests.
+	Responses bool
+}
+
+// SpecErrorCode responds code with the violations as field errors
+// instead of logging them.
+func SpecErrorCode(code int) SpecValidationFunc {
+	return func(v *SpecValidation) {
+		v.Code = code
+	}
+}
+
+// ValidateResponses validates the response bodies as well,
+// it should only be enabled in tests since every body is encoded once more.
+func ValidateResponses() SpecValidationFunc {
+	return func(v *SpecValidation) {
+		v.Responses = true
+	}
+}
@@ -0,0 +1,258 @@
+package opt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/tuotoo/biu/box"
+)
+
+var validate = validator.New()
+
+func init() {
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+}
+
+// ValidationCode sets the error code responded when parameters of
+// RouteAPI failed in validation, the default code is 400.
+func ValidationCode(code int) RouteFunc {
+	return func(route *Route) {
+		route.ValidationCode = code
+	}
+}
+
+// parseConstraints reads validation constraints from biu tags and validate tag.
+func (p *ParamOpt) parseConstraints(tags map[string]string, validateTag string) {
+	_, p.Required = tags[APITagRequired]
+	if v, ok := tags[APITagMin]; ok {
+		p.Minimum = mustParseFloat(p.FieldName, APITagMin, v)
+	}
+	if v, ok := tags[APITagMax]; ok {
+		p.Maximum = mustParseFloat(p.FieldName, APITagMax, v)
+	}
+	if v, ok := tags[APITagMinLength]; ok {
+		p.MinLength = mustParseInt(p.FieldName, APITagMinLength, v)
+	}
+	if v, ok := tags[APITagMaxLength]; ok {
+		p.MaxLength = mustParseInt(p.FieldName, APITagMaxLength, v)
+	}
+	if v, ok := tags[APITagPattern]; ok {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			log.Fatalf("invalid pattern of field %s: %v", p.FieldName, err)
+		}
+		p.Pattern = v
+		p.pattern = re
+	}
+	if v, ok := tags[APITagEnum]; ok {
+		p.Enum = strings.Split(v, ",")
+	}
+	p.Validate = validateTag
+	for _, rule := range strings.Split(validateTag, ",") {
+		if rule == "required" {
+			p.Required = true
+		}
+	}
+}
+
+func mustParseFloat(field, tag, s string) *float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		log.Fatalf("invalid %s of field %s: %v", tag, field, err)
+	}
+	return &v
+}
+
+func mustParseInt(field, tag, s string) *int64 {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid %s of field %s: %v", tag, field, err)
+	}
+	return &v
+}
+
+// validate checks the parameters of RouteAPI,
+// it responses the field errors and returns false if any check fails.
+func (plan *bindingPlan) validate(ctx box.Ctx, route *Route, sv reflect.Value) bool {
+	var errs box.FieldErrors
+	for i := range plan.binders {
+		b := &plan.binders[i]
+		if !b.checked {
+			continue
+		}
+		p := b.opt
+		in := strings.ToLower(p.FieldType.String())
+		for _, msg := range p.check(rawValues(ctx, p), fieldByIndex(sv, b.index)) {
+			errs = append(errs, box.FieldError{Field: p.Name, In: in, Message: msg})
+		}
+	}
+	if plan.body != nil && plan.body.validate {
+		errs = append(errs, validateBody(sv.FieldByIndex(plan.body.index))...)
+	}
+	if len(errs) == 0 {
+		return true
+	}
+	code := route.ValidationCode
+	if code == 0 {
+		code = http.StatusBadRequest
+	}
+	ctx.ResponseFieldErrors(code, errs)
+	return false
+}
+
+// check returns the messages of failed constraints.
+func (p ParamOpt) check(values []string, field reflect.Value) []string {
+	present := len(values) > 0
+	if p.Type == "file" {
+		present = field.IsValid() && !field.IsZero()
+	}
+	if !present {
+		if p.Required {
+			return []string{"is required"}
+		}
+		return nil
+	}
+	var msgs []string
+	for _, s := range values {
+		if p.Minimum != nil || p.Maximum != nil {
+			if n, err := strconv.ParseFloat(s, 64); err == nil {
+				if p.Minimum != nil && n < *p.Minimum {
+					msgs = append(msgs, "must be >= "+formatFloat(*p.Minimum))
+				}
+				if p.Maximum != nil && n > *p.Maximum {
+					msgs = append(msgs, "must be <= "+formatFloat(*p.Maximum))
+				}
+			}
+		}
+		length := int64(utf8.RuneCountInString(s))
+		if p.MinLength != nil && length < *p.MinLength {
+			msgs = append(msgs, fmt.Sprintf("length must be >= %d", *p.MinLength))
+		}
+		if p.MaxLength != nil && length > *p.MaxLength {
+			msgs = append(msgs, fmt.Sprintf("length must be <= %d", *p.MaxLength))
+		}
+		if p.pattern != nil && !p.pattern.MatchString(s) {
+			msgs = append(msgs, "must match pattern "+p.Pattern)
+		}
+		if len(p.Enum) > 0 && !contains(p.Enum, s) {
+			msgs = append(msgs, "must be one of "+strings.Join(p.Enum, ","))
+		}
+	}
+	if p.Validate != "" && field.IsValid() {
+		if err := validate.Var(field.Interface(), p.Validate); err != nil {
+			msgs = append(msgs, validationMessages(err)...)
+		}
+	}
+	return msgs
+}
+
+func validateBody(body reflect.Value) box.FieldErrors {
+	if !body.IsValid() {
+		return nil
+	}
+	t := body.Type()
+	if t.Kind() == reflect.Ptr {
+		if body.IsNil() {
+			return nil
+		}
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	err := validate.Struct(body.Interface())
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return nil
+	}
+	errs := make(box.FieldErrors, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		field := strings.TrimPrefix(fe.Namespace(), t.Name())
+		errs = append(errs, box.FieldError{
+			Field:   strings.TrimPrefix(field, "."),
+			In:      strings.ToLower(FieldBody.String()),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+	return errs
+}
+
+func validationMessages(err error) []string {
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return []string{err.Error()}
+	}
+	msgs := make([]string, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		msgs = append(msgs, fieldErrorMessage(fe))
+	}
+	return msgs
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	if fe.Param() != "" {
+		return fmt.Sprintf("failed on the '%s=%s' rule", fe.Tag(), fe.Param())
+	}
+	return fmt.Sprintf("failed on the '%s' rule", fe.Tag())
+}
+
+// rawValues returns the non-empty values of a parameter in request.
+func rawValues(ctx box.Ctx, p ParamOpt) []string {
+	var values []string
+	switch {
+	case p.IsMap:
+		for _, v := range mapValues(ctx, p) {
+			values = append(values, v)
+		}
+	case p.FieldType == FieldQuery:
+		values = ctx.QueryParameters(p.Name)
+	case p.FieldType == FieldPath:
+		values = []string{ctx.PathParameter(p.Name)}
+	case p.FieldType == FieldHeader:
+		values = ctx.Req().Header.Values(p.Name)
+	case p.FieldType == FieldForm:
+		values, _ = ctx.BodyParameterValues(p.Name)
+	case p.FieldType == FieldCookie:
+		if c, err := ctx.Req().Cookie(p.Name); err == nil {
+			values = []string{c.Value}
+		}
+	}
+	rst := values[:0:0]
+	for _, v := range values {
+		if v != "" {
+			rst = append(rst, v)
+		}
+	}
+	return rst
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func contains(arr []string, s string) bool {
+	for _, v := range arr {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package opt_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+type validateCtl struct{}
+
+func (ctl validateCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			ID string `biu:"pattern:^[a-z]{2}:\\d+$"`
+		}
+		Query struct {
+			Page  int      `biu:"required;min:1;max:10"`
+			Sort  string   `biu:"enum:asc,desc"`
+			Name  string   `biu:"minLength:2;maxLength:4"`
+			Email string   `validate:"omitempty,email"`
+			Tags  []string `biu:"maxLength:3"`
+			Sep   string   `biu:"pattern:^a\\x3bb$"`
+		}
+		Return func(string)
+	}) {
+		api.Return(api.Path.ID)
+	}))
+	ws.Route(ws.POST("/").Consumes(restful.MIME_JSON), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Body struct {
+			Name string `json:"name" validate:"required"`
+			Age  int    `json:"age" validate:"gte=18"`
+		}
+	}) {
+		ctx.ResponseJSON(api.Body.Name)
+	}), opt.ValidationCode(1001))
+}
+
+func TestValidation(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "v", Controller: validateCtl{}})
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.GET("/v/ab:12").WithQuery("page", 1).WithQuery("sort", "asc").WithQuery("name", "abc").
+		WithQuery("sep", "a;b").Expect().Status(http.StatusOK).JSON().Object().HasValue("code", 0).HasValue("data", "ab:12")
+
+	resp := e.GET("/v/12").
+		WithQuery("sort", "up").WithQuery("name", "a").WithQuery("email", "x").
+		WithQuery("tags", "a").WithQuery("tags", "abcd").WithQuery("sep", "a,b").
+		Expect().Status(http.StatusOK).JSON().Object()
+	resp.HasValue("code", http.StatusBadRequest).HasValue("message", "invalid parameters")
+	resp.Value("data").Array().IsEqual([]box.FieldError{
+		{Field: "id", In: "path", Message: `must match pattern ^[a-z]{2}:\d+$`},
+		{Field: "page", In: "query", Message: "is required"},
+		{Field: "sort", In: "query", Message: "must be one of asc,desc"},
+		{Field: "name", In: "query", Message: "length must be >= 2"},
+		{Field: "email", In: "query", Message: "failed on the 'email' rule"},
+		{Field: "tags", In: "query", Message: "length must be <= 3"},
+		{Field: "sep", In: "query", Message: `must match pattern ^a\x3bb$`},
+	})
+	e.GET("/v/ab:1").WithQuery("page", 11).
+		Expect().JSON().Object().Value("data").Array().IsEqual([]box.FieldError{
+		{Field: "page", In: "query", Message: "must be <= 10"},
+	})
+
+	e.POST("/v").WithJSON(map[string]interface{}{"name": "a", "age": 18}).
+		Expect().JSON().Object().HasValue("code", 0)
+	resp = e.POST("/v").WithJSON(map[string]interface{}{"age": 1}).Expect().JSON().Object()
+	resp.HasValue("code", 1001)
+	resp.Value("data").Array().IsEqual([]box.FieldError{
+		{Field: "name", In: "body", Message: "failed on the 'required' rule"},
+		{Field: "age", In: "body", Message: "failed on the 'gte=18' rule"},
+	})
+}
+
+func TestValidationCode(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.ValidationCode(1)(cfg)
+	assert.Equal(t, 1, cfg.ValidationCode)
+}
@@ -0,0 +1,78 @@
+package opt
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/internal"
+)
+
+// WebSocketFunc is the type of websocket options functions.
+type WebSocketFunc func(*WebSocketConfig)
+
+// WebSocketConfig is the options of a websocket route.
+type WebSocketConfig struct {
+	Handler      func(ctx box.Ctx, conn *websocket.Conn)
+	Upgrader     websocket.Upgrader
+	ReadLimit    int64
+	PingInterval time.Duration
+	PongWait     time.Duration
+	WriteWait    time.Duration
+}
+
+// WebSocket binds a websocket handler to a route.
+// The request is upgraded after passing through all the filters of the route,
+// browsers can pass the JWT by the access_token query parameter for AuthFilter.
+// Pongs are only handled while reading, so the handler should keep reading
+// from the connection until it returns an error.
+func WebSocket(f func(ctx box.Ctx, conn *websocket.Conn), opts ...WebSocketFunc) RouteFunc {
+	cfg := &WebSocketConfig{
+		Handler:      f,
+		ReadLimit:    1 << 20,
+		PingInterval: 30 * time.Second,
+		PongWait:     60 * time.Second,
+		WriteWait:    10 * time.Second,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(cfg)
+		}
+	}
+	if cfg.PingInterval > 0 && cfg.PongWait > 0 && cfg.PongWait <= cfg.PingInterval {
+		log.Fatalf("pong wait %s of websocket must be longer than the ping interval %s",
+			cfg.PongWait, cfg.PingInterval)
+	}
+	return func(route *Route) {
+		route.ID = internal.NameOfFunction(f)
+		route.WebSocket = cfg
+	}
+}
+
+// WSReadLimit sets the maximum size in bytes of a message read from peer.
+func WSReadLimit(limit int64) WebSocketFunc {
+	return func(cfg *WebSocketConfig) {
+		cfg.ReadLimit = limit
+	}
+}
+
+// WSKeepAlive sends a ping every interval,
+// and closes the connection if no pong is received in pongWait,
+// which must be longer than interval, or the route can not be registered.
+func WSKeepAlive(interval, pongWait time.Duration) WebSocketFunc {
+	return func(cfg *WebSocketConfig) {
+		cfg.PingInterval = interval
+		cfg.PongWait = pongWait
+	}
+}
+
+// WSCheckOrigin sets the function to validate the Origin of handshake.
+// By default, cross origin requests are rejected.
+func WSCheckOrigin(f func(r *http.Request) bool) WebSocketFunc {
+	return func(cfg *WebSocketConfig) {
+		cfg.Upgrader.CheckOrigin = f
+	}
+}
@@ -0,0 +1,28 @@
+package opt_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+func TestWebSocket(t *testing.T) {
+	cfg := &opt.Route{}
+	opt.WebSocket(func(ctx box.Ctx, conn *websocket.Conn) {},
+		opt.WSReadLimit(10),
+		opt.WSKeepAlive(time.Second, 2*time.Second),
+		opt.WSCheckOrigin(func(r *http.Request) bool { return true }),
+	)(cfg)
+	assert.NotNil(t, cfg.WebSocket)
+	assert.NotEmpty(t, cfg.ID)
+	assert.Equal(t, int64(10), cfg.WebSocket.ReadLimit)
+	assert.Equal(t, time.Second, cfg.WebSocket.PingInterval)
+	assert.Equal(t, 2*time.Second, cfg.WebSocket.PongWait)
+	assert.True(t, cfg.WebSocket.Upgrader.CheckOrigin(nil))
+}
@@ -0,0 +1,290 @@
+package biu
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/go-openapi/spec"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/log"
+	"github.com/tuotoo/biu/openapi"
+	"github.com/tuotoo/biu/opt"
+)
+
+// specBodyLimit is the max size of a JSON body validated against its schema,
+// larger bodies are passed without validation, so are the bodies larger than
+// opt.MaxBodySize of the route, which are rejected by the route.
+const specBodyLimit = 10 << 20
+
+// SpecValidationFilter validates requests against the OpenAPI document of the container,
+// the parameters, content type and JSON body are checked. With opt.ValidateResponses,
+// the successful response bodies are checked as well.
+// The violations are logged unless an error code is set by opt.SpecErrorCode.
+// The document is built at the first request, so the filter should be added
+// after all routes are registered.
+//
+//	c.Filter(c.SpecValidationFilter(opt.SpecErrorCode(400)))
+func (c *Container) SpecValidationFilter(opts ...opt.SpecValidationFunc) restful.FilterFunction {
+	cfg := &opt.SpecValidation{}
+	for _, f := range opts {
+		f(cfg)
+	}
+	v := &specValidator{container: c, cfg: cfg}
+	return c.FilterFunc(v.filter)
+}
+
+// SpecValidationFilter validates requests against the OpenAPI document of default container.
+func SpecValidationFilter(opts ...opt.SpecValidationFunc) restful.FilterFunction {
+	return DefaultContainer.SpecValidationFilter(opts...)
+}
+
+type specValidator struct {
+	container *Container
+	cfg       *opt.SpecValidation
+	once      sync.Once
+	doc       *openapi.Document
+	validator *openapi.Validator
+}
+
+// operation returns the operation of current route, nil if it is not documented.
+func (v *specValidator) operation(ctx box.Ctx) *openapi.Operation {
+	v.once.Do(func() {
+		doc, err := buildOpenAPI(v.container, SwaggerInfo{internal: true})
+		if err != nil {
+			v.container.logger.Info(log.BiuInternalInfo{Err: fmt.Errorf("spec validation disabled: %w", err)})
+			return
+		}
+		v.doc, v.validator = doc, doc.NewValidator()
+	})
+	if v.doc == nil {
+		return nil
+	}
+	return v.doc.Paths[docPath(ctx.SelectedRoutePath())][strings.ToLower(ctx.Req().Method)]
+}
+
+func (v *specValidator) filter(ctx box.Ctx) {
+	op := v.operation(ctx)
+	if op == nil {
+		ctx.Next()
+		return
+	}
+	errs := v.parameters(ctx, op.Parameters)
+	errs = append(errs, v.requestBody(ctx, op.RequestBody)...)
+	if len(errs) > 0 && v.report(ctx, "request", errs) {
+		return
+	}
+	ctx.Next()
+	if v.cfg.Responses {
+		if errs := v.response(ctx, op.Responses); len(errs) > 0 {
+			v.report(ctx, "response", errs)
+		}
+	}
+}
+
+// report logs the violations or responds them with the error code,
+// it reports whether the violations are responded.
+func (v *specValidator) report(ctx box.Ctx, kind string, errs box.FieldErrors) bool {
+	if v.cfg.Code == 0 {
+		ctx.Logger.Info(log.BiuInternalInfo{
+			Err: errs,
+			Extras: map[string]interface{}{
+				"Route": ctx.RouteSignature(),
+				"Spec":  kind,
+			},
+		})
+		return false
+	}
+	if kind == "response" {
+		ctx.ResponseErrorData(v.cfg.Code, "invalid response", errs)
+	} else {
+		ctx.ResponseFieldErrors(v.cfg.Code, errs)
+	}
+	return true
+}
+
+func (v *specValidator) parameters(ctx box.Ctx, params []openapi.Parameter) box.FieldErrors {
+	var errs box.FieldErrors
+	for _, p := range params {
+		if p.Style == openapi.StyleDeepObject {
+			continue
+		}
+		values := paramValues(ctx, p)
+		if len(values) == 0 {
+			if p.Required {
+				errs = append(errs, box.FieldError{Field: p.Name, In: p.In, Message: "required"})
+			}
+			continue
+		}
+		errs = append(errs, v.validate(p.Name, p.In, p.Schema, paramValue(p, values))...)
+	}
+	return errs
+}
+
+func paramValues(ctx box.Ctx, p openapi.Parameter) []string {
+	switch p.In {
+	case "query":
+		return ctx.Req().URL.Query()[p.Name]
+	case "header":
+		return ctx.Req().Header.Values(p.Name)
+	case "path":
+		if s := ctx.PathParameter(p.Name); s != "" {
+			return []string{s}
+		}
+	case "cookie":
+		if c, err := ctx.Req().Cookie(p.Name); err == nil {
+			return []string{c.Value}
+		}
+	}
+	return nil
+}
+
+// paramValue converts the values of a parameter to the type of its schema,
+// a value which can not be converted is kept as a string to fail in validation.
+func paramValue(p openapi.Parameter, values []string) interface{} {
+	if p.Schema == nil || !p.Schema.Type.Contains("array") {
+		return typedDefault(values[0], schemaType(p.Schema))
+	}
+	sep := ""
+	switch {
+	case p.Style == "pipeDelimited":
+		sep = "|"
+	case p.Style == "spaceDelimited":
+		sep = " "
+	case p.Explode != nil && !*p.Explode, p.In != "query":
+		sep = ","
+	}
+	var itemType string
+	if p.Schema.Items != nil {
+		itemType = schemaType(p.Schema.Items.Schema)
+	}
+	items := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		parts := []string{value}
+		if sep != "" {
+			parts = strings.Split(value, sep)
+		}
+		for _, s := range parts {
+			items = append(items, typedDefault(s, itemType))
+		}
+	}
+	return items
+}
+
+func schemaType(s *spec.Schema) string {
+	if s == nil || len(s.Type) == 0 {
+		return ""
+	}
+	return s.Type[0]
+}
+
+func (v *specValidator) requestBody(ctx box.Ctx, body *openapi.RequestBody) box.FieldErrors {
+	r := ctx.Req()
+	if body == nil || r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		if body != nil && body.Required {
+			return box.FieldErrors{{In: "body", Message: "required"}}
+		}
+		return nil
+	}
+	contentType := r.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return box.FieldErrors{{Field: "Content-Type", In: "header", Message: "invalid media type " + strconv.Quote(contentType)}}
+	}
+	content, ok := body.Content[mediaType]
+	if !ok {
+		return box.FieldErrors{{Field: "Content-Type", In: "header", Message: "unsupported media type " + mediaType}}
+	}
+	if content.Schema == nil || (mediaType != restful.MIME_JSON && !strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+	limit := int64(specBodyLimit)
+	if n, ok := ctx.SelectedRoute().Metadata()[metaMaxBodySize].(int64); ok && n < limit {
+		limit = n
+	}
+	if r.ContentLength > limit {
+		return nil
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+	if err != nil {
+		return box.FieldErrors{{In: "body", Message: err.Error()}}
+	}
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(data), r.Body), Closer: r.Body}
+	if int64(len(data)) > limit {
+		return nil
+	}
+	if !json.Valid(data) {
+		return box.FieldErrors{{In: "body", Message: "invalid JSON"}}
+	}
+	return v.validate("", "body", content.Schema, json.RawMessage(data))
+}
+
+// readCloser restores a read body and closes the original one.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func (v *specValidator) response(ctx box.Ctx, responses map[string]*openapi.Response) box.FieldErrors {
+	if ctx.IsRawResponse() {
+		return nil
+	}
+	if code, ok := ctx.Attribute(box.BiuAttrErrCode).(int); ok && code != 0 {
+		return nil
+	}
+	entities, ok := ctx.Attribute(box.BiuAttrEntities).([]interface{})
+	if !ok || len(entities) < 1 {
+		return nil
+	}
+	status, ok := ctx.Attribute(box.BiuAttrStatus).(int)
+	if !ok || status == 0 {
+		status = http.StatusOK
+	}
+	if !bodyAllowed(status) {
+		return nil
+	}
+	resp, ok := responses[strconv.Itoa(status)]
+	if !ok {
+		for _, r := range responses {
+			if r.Content[restful.MIME_JSON].Schema != nil {
+				return box.FieldErrors{{In: "response", Message: fmt.Sprintf("status %d is not documented", status)}}
+			}
+		}
+		return nil
+	}
+	routeID, _ := ctx.Attribute(box.BiuAttrRouteID).(string)
+	var body interface{} = box.CommonResp{Data: entities[0], RouteID: routeID}
+	if noEnvelope, _ := ctx.Attribute(box.BiuAttrNoEnvelope).(bool); noEnvelope {
+		body = entities[0]
+	}
+	return v.validate("", "response", resp.Content[restful.MIME_JSON].Schema, body)
+}
+
+// validate validates value against schema, the fields of errors are prefixed by field.
+func (v *specValidator) validate(field, in string, schema *spec.Schema, value interface{}) box.FieldErrors {
+	schemaErrs, err := v.validator.Validate(schema, value)
+	if err != nil {
+		return box.FieldErrors{{Field: field, In: in, Message: err.Error()}}
+	}
+	errs := make(box.FieldErrors, 0, len(schemaErrs))
+	for _, e := range schemaErrs {
+		name := e.Field
+		switch {
+		case field == "":
+		case name == "":
+			name = field
+		default:
+			name = field + "." + name
+		}
+		errs = append(errs, box.FieldError{Field: name, In: in, Message: e.Message})
+	}
+	return errs
+}
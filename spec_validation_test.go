@@ -0,0 +1,112 @@
+package biu_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+type specUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type specCtl struct{}
+
+func (ctl specCtl) WebService(ws biu.WS) {
+	ws.Route(ws.PUT("/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			ID int
+		}
+		Query struct {
+			Tags []int `biu:"name:tags"`
+		}
+		Header struct {
+			Page int `biu:"name:X-Page;required;min:1"`
+		}
+		Body struct {
+			Name string `json:"name"`
+		}
+		Return func(specUser)
+	}) {
+		api.Return(specUser{ID: api.Path.ID, Name: api.Body.Name})
+	}))
+	ws.Route(ws.GET("/items/{id:[0-9]+}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Header struct {
+			Page int `biu:"name:X-Page;required"`
+		}
+		Return func(specUser)
+	}) {
+		api.Return(specUser{})
+	}))
+	ws.Route(ws.POST("/small"), opt.MaxBodySize(16), opt.StrictBinding(4001), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Body struct {
+			Name string `json:"name"`
+		}
+	}) {
+	}))
+	ws.Route(ws.GET("/drift"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(specUser)
+	}) {
+		ctx.ResponseJSON(map[string]interface{}{"id": "1", "name": "tom"})
+	}))
+}
+
+func TestSpecValidationFilter(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "spec", Controller: specCtl{}})
+	c.Filter(c.SpecValidationFilter(opt.SpecErrorCode(4000), opt.ValidateResponses()))
+	e := httpexpect.Default(t, swaggerURL(t, c))
+
+	e.PUT("/spec/1").WithQuery("tags", "1").WithQuery("tags", "2").WithHeader("X-Page", "1").
+		WithJSON(map[string]interface{}{"name": "tom"}).
+		Expect().Status(http.StatusOK).JSON().Path("$.data").
+		IsEqual(map[string]interface{}{"id": 1, "name": "tom"})
+
+	data := e.PUT("/spec/x").WithQuery("tags", "1").WithQuery("tags", "a").WithHeader("X-Page", "0").
+		WithJSON(map[string]interface{}{"name": 1}).
+		Expect().JSON().Object().
+		HasValue("code", 4000).HasValue("message", "invalid parameters").
+		Value("data").Array()
+	data.Length().IsEqual(4)
+	data.Value(0).Object().HasValue("in", "header").HasValue("field", "X-Page").
+		HasValue("message", "Must be greater than or equal to 1")
+	data.Value(1).Object().HasValue("in", "path").HasValue("field", "id")
+	data.Value(2).Object().HasValue("in", "query").HasValue("field", "tags.1")
+	data.Value(3).Object().HasValue("in", "body").HasValue("field", "name")
+
+	e.PUT("/spec/1").WithText("tom").
+		Expect().JSON().Object().HasValue("code", 4000).Value("data").Array().
+		IsEqual([]box.FieldError{
+			{Field: "X-Page", In: "header", Message: "required"},
+			{Field: "Content-Type", In: "header", Message: "unsupported media type text/plain"},
+		})
+
+	e.GET("/spec/drift").Expect().JSON().Object().
+		HasValue("code", 4000).HasValue("message", "invalid response").
+		Path("$.data[0]").Object().HasValue("in", "response").HasValue("field", "data.id")
+
+	e.GET("/spec/items/1").Expect().JSON().Object().
+		HasValue("code", 4000).Path("$.data[0].field").IsEqual("X-Page")
+	e.GET("/spec/items/1").WithHeader("X-Page", "1").Expect().JSON().Object().HasValue("code", 0)
+
+	e.POST("/spec/small").WithJSON(map[string]interface{}{"name": 1, "note": "too large"}).
+		Expect().JSON().Object().HasValue("code", 4001)
+	e.POST("/spec/small").WithJSON(map[string]interface{}{"name": 1}).
+		Expect().JSON().Object().HasValue("code", 4000).Path("$.data[0].field").IsEqual("name")
+}
+
+func TestSpecValidationFilterLog(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "spec", Controller: specCtl{}})
+	c.Filter(c.SpecValidationFilter())
+	e := httpexpect.Default(t, swaggerURL(t, c))
+	e.GET("/spec/drift").Expect().Status(http.StatusOK).JSON().Path("$.data.id").IsEqual("1")
+	e.PUT("/spec/1").WithJSON(map[string]interface{}{"name": "tom"}).
+		Expect().JSON().Object().HasValue("code", http.StatusBadRequest).
+		Path("$.data[0].field").IsEqual("X-Page")
+}
@@ -0,0 +1,179 @@
+package specdiff
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+// direction is whether a schema is sent or received by clients,
+// which decides if a change of it is breaking.
+type direction int
+
+const (
+	request direction = iota
+	response
+)
+
+const schemaPrefix = "#/components/schemas/"
+
+func (d *differ) compareSchema(r route, location string, dir direction, old, cur *spec.Schema) {
+	d.walkSchema(r, location, "", dir, old, cur, make(map[string]bool))
+}
+
+// walkSchema compares the fields of old and cur recursively,
+// seen holds the references being compared in the current path to stop at recursive ones,
+// a reference is compared again under other fields so its changes are reported for each of them.
+func (d *differ) walkSchema(r route, location, field string, dir direction, old, cur *spec.Schema, seen map[string]bool) {
+	if old == nil || cur == nil {
+		return
+	}
+	if oldRef, curRef := old.Ref.String(), cur.Ref.String(); oldRef != "" || curRef != "" {
+		key := oldRef + " " + curRef
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		defer delete(seen, key)
+	}
+	old, cur = resolve(d.old, old), resolve(d.cur, cur)
+	where := location
+	if field != "" {
+		where += " " + field
+	}
+
+	if oldType, curType := typeName(old), typeName(cur); oldType != "" && curType != "" && oldType != curType {
+		d.add(r, true, where, "type changed from %s to %s", oldType, curType)
+		return
+	}
+	d.compareEnum(r, where, dir, old.Enum, cur.Enum)
+
+	join := func(name string) string {
+		if field == "" {
+			return name
+		}
+		return field + "." + name
+	}
+	oldRequired, curRequired := set(old.Required), set(cur.Required)
+	for _, name := range sortedKeys(old.Properties) {
+		curProp, ok := cur.Properties[name]
+		if !ok {
+			d.add(r, dir == response, location+" "+join(name), "field removed")
+			continue
+		}
+		switch {
+		case dir == request && !oldRequired[name] && curRequired[name]:
+			d.add(r, true, location+" "+join(name), "field became required")
+		case dir == response && oldRequired[name] && !curRequired[name]:
+			d.add(r, true, location+" "+join(name), "field became optional")
+		}
+		oldProp := old.Properties[name]
+		d.walkSchema(r, location, join(name), dir, &oldProp, &curProp, seen)
+	}
+	for _, name := range sortedKeys(cur.Properties) {
+		if _, ok := old.Properties[name]; ok {
+			continue
+		}
+		if dir == request && curRequired[name] {
+			d.add(r, true, location+" "+join(name), "required field added")
+		} else {
+			d.add(r, false, location+" "+join(name), "field added")
+		}
+	}
+	if old.Items != nil && cur.Items != nil {
+		d.walkSchema(r, location, field+"[]", dir, old.Items.Schema, cur.Items.Schema, seen)
+	}
+	if old.AdditionalProperties != nil && cur.AdditionalProperties != nil {
+		d.walkSchema(r, location, field+"{}", dir,
+			old.AdditionalProperties.Schema, cur.AdditionalProperties.Schema, seen)
+	}
+}
+
+// compareEnum compares the possible values,
+// they can not be narrowed in requests.
+func (d *differ) compareEnum(r route, where string, dir direction, old, cur []interface{}) {
+	if len(old) == 0 && len(cur) == 0 {
+		return
+	}
+	if len(old) == 0 {
+		d.add(r, dir == request, where, "enum %s added", values(cur))
+		return
+	}
+	if len(cur) == 0 {
+		d.add(r, false, where, "enum removed")
+		return
+	}
+	oldValues, curValues := set(stringify(old)), set(stringify(cur))
+	var removed, added []interface{}
+	for _, v := range old {
+		if !curValues[fmt.Sprint(v)] {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range cur {
+		if !oldValues[fmt.Sprint(v)] {
+			added = append(added, v)
+		}
+	}
+	if len(removed) > 0 {
+		d.add(r, dir == request, where, "enum narrowed, %s removed", values(removed))
+	}
+	if len(added) > 0 {
+		d.add(r, false, where, "enum widened, %s added", values(added))
+	}
+}
+
+// resolve returns the schema referenced by s in the components of doc.
+func resolve(doc *openapi.Document, s *spec.Schema) *spec.Schema {
+	for i := 0; i < 8 && s.Ref.String() != "" && doc.Components != nil; i++ {
+		name, err := url.PathUnescape(strings.TrimPrefix(s.Ref.String(), schemaPrefix))
+		if err != nil {
+			return s
+		}
+		target, ok := doc.Components.Schemas[name]
+		if !ok {
+			return s
+		}
+		s = &target
+	}
+	return s
+}
+
+// typeName returns the type and format of a schema, null is ignored.
+func typeName(s *spec.Schema) string {
+	var types []string
+	for _, t := range s.Type {
+		if t != "null" {
+			types = append(types, t)
+		}
+	}
+	name := strings.Join(types, "|")
+	if s.Format != "" {
+		name += "(" + s.Format + ")"
+	}
+	return name
+}
+
+func stringify(list []interface{}) []string {
+	rst := make([]string, 0, len(list))
+	for _, v := range list {
+		rst = append(rst, fmt.Sprint(v))
+	}
+	return rst
+}
+
+func values(list []interface{}) string {
+	return strings.Join(stringify(list), ", ")
+}
+
+func set(list []string) map[string]bool {
+	rst := make(map[string]bool, len(list))
+	for _, v := range list {
+		rst[v] = true
+	}
+	return rst
+}
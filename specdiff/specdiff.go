@@ -0,0 +1,251 @@
+// Package specdiff compares two API documents of biu,
+// and classifies the changes as breaking or not for clients.
+//
+//	old, _ := openapi.Parse(oldData)
+//	cur, _ := openapi.Parse(newData)
+//	report := specdiff.Compare(old, cur)
+//	if report.Breaking() {
+//		fmt.Print(report)
+//	}
+package specdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tuotoo/biu/openapi"
+)
+
+// Change is a change between two documents.
+type Change struct {
+	Breaking bool `json:"breaking"`
+	// Method and Path are the route of the change, empty for the whole document.
+	Method string `json:"method,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Location is the part of the route changed, e.g. "query parameter page" or "response 200 data.name".
+	Location string `json:"location,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (c Change) String() string {
+	kind := "non-breaking"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	where := strings.TrimSpace(c.Method + " " + c.Path)
+	if where == "" {
+		where = "document"
+	}
+	if c.Location != "" {
+		where += " " + c.Location
+	}
+	return fmt.Sprintf("[%s] %s: %s", kind, where, c.Message)
+}
+
+// Report is the changes between two documents.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Breaking reports whether any of the changes is breaking.
+func (r Report) Breaking() bool {
+	for _, c := range r.Changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the changes line by line and a summary.
+func (r Report) String() string {
+	var b strings.Builder
+	var breaking int
+	for _, c := range r.Changes {
+		if c.Breaking {
+			breaking++
+		}
+		b.WriteString(c.String())
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, "%d breaking, %d non-breaking changes\n", breaking, len(r.Changes)-breaking)
+	return b.String()
+}
+
+// Compare returns the changes from old to cur.
+func Compare(old, cur *openapi.Document) Report {
+	d := &differ{old: old, cur: cur, changes: []Change{}}
+	d.compareCodes(route{}, "", old.Extensions, cur.Extensions)
+	for _, path := range sortedKeys(old.Paths) {
+		for _, method := range sortedKeys(old.Paths[path]) {
+			r := route{method: strings.ToUpper(method), path: path}
+			curOp := cur.Paths[path][method]
+			if curOp == nil {
+				d.add(r, true, "", "route removed")
+				continue
+			}
+			d.compareOperation(r, old.Paths[path][method], curOp)
+		}
+	}
+	for _, path := range sortedKeys(cur.Paths) {
+		for _, method := range sortedKeys(cur.Paths[path]) {
+			if old.Paths[path][method] == nil {
+				d.add(route{method: strings.ToUpper(method), path: path}, false, "", "route added")
+			}
+		}
+	}
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		a, b := d.changes[i], d.changes[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Method < b.Method
+	})
+	return Report{Changes: d.changes}
+}
+
+// route is the method and path of an operation.
+type route struct {
+	method string
+	path   string
+}
+
+type differ struct {
+	old, cur *openapi.Document
+	changes  []Change
+}
+
+func (d *differ) add(r route, breaking bool, location, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{
+		Breaking: breaking,
+		Method:   r.method,
+		Path:     r.path,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) compareOperation(r route, old, cur *openapi.Operation) {
+	if !old.Deprecated && cur.Deprecated {
+		d.add(r, false, "", "route deprecated")
+	}
+	d.compareCodes(r, "", old.Extensions, cur.Extensions)
+	d.compareParameters(r, old.Parameters, cur.Parameters)
+	d.compareRequestBody(r, old.RequestBody, cur.RequestBody)
+	for _, code := range sortedKeys(old.Responses) {
+		location := "response " + code
+		curResp, ok := cur.Responses[code]
+		if !ok {
+			d.add(r, strings.HasPrefix(code, "2"), location, "response removed")
+			continue
+		}
+		d.compareContent(r, location, response, old.Responses[code].Content, curResp.Content)
+	}
+	for _, code := range sortedKeys(cur.Responses) {
+		if _, ok := old.Responses[code]; !ok {
+			d.add(r, false, "response "+code, "response added")
+		}
+	}
+}
+
+// compareCodes compares the business error codes of ExtCodes.
+func (d *differ) compareCodes(r route, location string, old, cur openapi.Extensions) {
+	oldCodes, curCodes := codes(old), codes(cur)
+	for _, code := range sortedKeys(oldCodes) {
+		msg, ok := curCodes[code]
+		switch {
+		case !ok:
+			d.add(r, true, location, "error code %s (%s) removed", code, oldCodes[code])
+		case msg != oldCodes[code]:
+			d.add(r, false, location, "message of error code %s changed from %q to %q", code, oldCodes[code], msg)
+		}
+	}
+	for _, code := range sortedKeys(curCodes) {
+		if _, ok := oldCodes[code]; !ok {
+			d.add(r, false, location, "error code %s (%s) added", code, curCodes[code])
+		}
+	}
+}
+
+// codes returns the error codes of ExtCodes,
+// which are map[string]string in built documents or decoded from JSON.
+func codes(ext openapi.Extensions) map[string]string {
+	var rst map[string]string
+	if bs, err := json.Marshal(ext[openapi.ExtCodes]); err == nil {
+		_ = json.Unmarshal(bs, &rst)
+	}
+	return rst
+}
+
+func (d *differ) compareParameters(r route, old, cur []openapi.Parameter) {
+	key := func(p openapi.Parameter) string { return p.In + " parameter " + p.Name }
+	curParams := make(map[string]openapi.Parameter, len(cur))
+	for _, p := range cur {
+		curParams[key(p)] = p
+	}
+	oldParams := make(map[string]openapi.Parameter, len(old))
+	for _, p := range old {
+		location := key(p)
+		oldParams[location] = p
+		curParam, ok := curParams[location]
+		if !ok {
+			d.add(r, false, location, "parameter removed")
+			continue
+		}
+		if !p.Required && curParam.Required {
+			d.add(r, true, location, "parameter became required")
+		}
+		d.compareSchema(r, location, request, p.Schema, curParam.Schema)
+	}
+	for _, p := range cur {
+		location := key(p)
+		if _, ok := oldParams[location]; ok {
+			continue
+		}
+		if p.Required {
+			d.add(r, true, location, "required parameter added")
+		} else {
+			d.add(r, false, location, "optional parameter added")
+		}
+	}
+}
+
+func (d *differ) compareRequestBody(r route, old, cur *openapi.RequestBody) {
+	const location = "request body"
+	switch {
+	case old == nil && cur == nil:
+		return
+	case old == nil:
+		d.add(r, cur.Required, location, "request body added")
+		return
+	case cur == nil:
+		d.add(r, false, location, "request body removed")
+		return
+	}
+	if !old.Required && cur.Required {
+		d.add(r, true, location, "request body became required")
+	}
+	d.compareContent(r, location, request, old.Content, cur.Content)
+}
+
+func (d *differ) compareContent(r route, location string, dir direction, old, cur map[string]openapi.MediaType) {
+	for _, mime := range sortedKeys(old) {
+		curMedia, ok := cur[mime]
+		if !ok {
+			d.add(r, true, location, "media type %s removed", mime)
+			continue
+		}
+		d.compareSchema(r, location, dir, old[mime].Schema, curMedia.Schema)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,197 @@
+package specdiff_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/openapi"
+	"github.com/tuotoo/biu/opt"
+	"github.com/tuotoo/biu/specdiff"
+)
+
+type userV1 struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type createV1 struct {
+	Name string `json:"name"`
+}
+
+type ctlV1 struct{}
+
+func (ctl ctlV1) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/{id}"), opt.RouteErrors(map[int]string{1001: "not found"}),
+		opt.RouteAPI(func(ctx box.Ctx, api struct {
+			Query struct {
+				Fields []string `biu:"enum:name,email"`
+			}
+			Return func(userV1)
+		}) {
+		}))
+	ws.Route(ws.DELETE("/{id}"))
+	ws.Route(ws.POST("/").Consumes(restful.MIME_JSON), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Body createV1
+	}) {
+	}))
+}
+
+type userV2 struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+type createV2 struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+type ctlV2 struct{}
+
+func (ctl ctlV2) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/{id}"), opt.RouteErrors(map[int]string{1002: "forbidden"}),
+		opt.RouteAPI(func(ctx box.Ctx, api struct {
+			Query struct {
+				Fields []string `biu:"enum:name,age"`
+				Page   int      `biu:"required"`
+			}
+			Return func(userV2)
+		}) {
+		}))
+	ws.Route(ws.GET("/"))
+	ws.Route(ws.POST("/").Consumes(restful.MIME_JSON), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Body createV2
+	}) {
+	}))
+}
+
+func build(t *testing.T, ctl biu.CtlInterface) *openapi.Document {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "users", Controller: ctl})
+	doc, err := c.BuildOpenAPI(biu.SwaggerInfo{})
+	assert.NoError(t, err)
+	return doc
+}
+
+func TestCompare(t *testing.T) {
+	report := specdiff.Compare(build(t, ctlV1{}), build(t, ctlV2{}))
+	assert.True(t, report.Breaking())
+	assert.Equal(t, `[non-breaking] GET /users: route added
+[breaking] POST /users request body age: required field added
+[breaking] DELETE /users/{id}: route removed
+[breaking] GET /users/{id}: error code 1001 (not found) removed
+[non-breaking] GET /users/{id}: error code 1002 (forbidden) added
+[breaking] GET /users/{id} query parameter fields: enum narrowed, email removed
+[non-breaking] GET /users/{id} query parameter fields: enum widened, age added
+[breaking] GET /users/{id} query parameter page: required parameter added
+[breaking] GET /users/{id} response 200 data.email: field removed
+[breaking] GET /users/{id} response 200 data.id: type changed from string to integer(int32)
+[non-breaking] GET /users/{id} response 200 data.age: field added
+7 breaking, 4 non-breaking changes
+`, report.String())
+	assert.Equal(t, specdiff.Change{
+		Breaking: true,
+		Method:   "DELETE",
+		Path:     "/users/{id}",
+		Message:  "route removed",
+	}, report.Changes[2])
+
+	report = specdiff.Compare(build(t, ctlV2{}), build(t, ctlV1{}))
+	assert.Contains(t, report.Changes, specdiff.Change{
+		Method:   "POST",
+		Path:     "/users",
+		Location: "request body age",
+		Message:  "field removed",
+	})
+	assert.Contains(t, report.Changes, specdiff.Change{
+		Breaking: true,
+		Method:   "GET",
+		Path:     "/users",
+		Message:  "route removed",
+	})
+}
+
+func TestCompareParsed(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", opt.ServicesFuncArr{opt.ServiceErrors(map[int]string{1: "unknown"})},
+		biu.NS{NameSpace: "users", Controller: ctlV1{}})
+	swo, err := c.BuildSwagger(biu.SwaggerInfo{})
+	assert.NoError(t, err)
+	bs, err := json.Marshal(swo)
+	assert.NoError(t, err)
+	old, err := openapi.Parse(bs)
+	assert.NoError(t, err)
+	doc, err := c.BuildOpenAPI(biu.SwaggerInfo{})
+	assert.NoError(t, err)
+	bs, err = openapi.YAML(doc)
+	assert.NoError(t, err)
+	cur, err := openapi.Parse(bs)
+	assert.NoError(t, err)
+	assert.Empty(t, specdiff.Compare(old, cur).Changes)
+
+	delete(cur.Extensions, openapi.ExtCodes)
+	report := specdiff.Compare(old, cur)
+	assert.Equal(t, "[breaking] document: error code 1 (unknown) removed\n1 breaking, 0 non-breaking changes\n",
+		report.String())
+}
+
+type nodeV1 struct {
+	Name     string   `json:"name"`
+	Children []nodeV1 `json:"children"`
+}
+
+type teamV1 struct {
+	Owner userV1 `json:"owner"`
+	Admin userV1 `json:"admin"`
+	Root  nodeV1 `json:"root"`
+}
+
+type teamCtlV1 struct{}
+
+func (ctl teamCtlV1) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(teamV1)
+	}) {
+	}))
+}
+
+type nodeV2 struct {
+	Children []nodeV2 `json:"children"`
+}
+
+type teamV2 struct {
+	Owner userV2 `json:"owner"`
+	Admin userV2 `json:"admin"`
+	Root  nodeV2 `json:"root"`
+}
+
+type teamCtlV2 struct{}
+
+func (ctl teamCtlV2) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(teamV2)
+	}) {
+	}))
+}
+
+func TestCompareSharedRefs(t *testing.T) {
+	report := specdiff.Compare(build(t, teamCtlV1{}), build(t, teamCtlV2{}))
+	var locations []string
+	for _, c := range report.Changes {
+		if c.Message == "field removed" {
+			locations = append(locations, c.Location)
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		"response 200 data.admin.email",
+		"response 200 data.owner.email",
+		"response 200 data.root.name",
+	}, locations)
+}
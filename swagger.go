@@ -2,13 +2,23 @@ package biu
 
 import (
 	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
 	"github.com/go-openapi/spec"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/log"
+	"github.com/tuotoo/biu/openapi"
 )
 
 //go:embed swagger/*
@@ -28,39 +38,224 @@ func newSwaggerService(
 	container *Container,
 	info SwaggerInfo,
 ) *restful.WebService {
+	if err := checkInfo(container, info); err != nil {
+		container.logger.Fatal(log.BiuInternalInfo{Err: err})
+	}
+	config := swaggerConfig(container, &info, "swagger")
+	docs := groupDocs(&info, func(group SwaggerInfo) interface{} {
+		return restfulspec.BuildSwagger(swaggerConfig(container, &group, "swagger"))
+	})
+	serveSwaggerUI(container, info.RoutePrefix+info.RouteSuffix, info, docs)
+	return restfulspec.NewOpenAPIService(config)
+}
+
+// NewOpenAPIService creates an OpenAPI 3.1 webservice in /openapi
+func (c *Container) NewOpenAPIService(info SwaggerInfo) *restful.WebService {
+	return newOpenAPIService(c, info)
+}
+
+// NewOpenAPIService creates an OpenAPI 3.1 webservice in /openapi
+func NewOpenAPIService(info SwaggerInfo) *restful.WebService {
+	return newOpenAPIService(DefaultContainer, info)
+}
+
+func newOpenAPIService(
+	container *Container,
+	info SwaggerInfo,
+) *restful.WebService {
+	if err := checkInfo(container, info); err != nil {
+		container.logger.Fatal(log.BiuInternalInfo{Err: err})
+	}
+	config := swaggerConfig(container, &info, "openapi")
+	docs := groupDocs(&info, func(group SwaggerInfo) interface{} {
+		return openapi.FromSwagger(restfulspec.BuildSwagger(swaggerConfig(container, &group, "openapi")))
+	})
+	serveSwaggerUI(container, info.RoutePrefix+info.RouteSuffix, info, docs)
+	doc := openapi.FromSwagger(restfulspec.BuildSwagger(config))
+
+	ws := new(restful.WebService)
+	ws.Path(config.APIPath)
+	ws.Produces(restful.MIME_JSON)
+	if !config.DisableCORS {
+		ws.Filter(enableCORS)
+	}
+	ws.Route(ws.GET("/").To(func(req *restful.Request, resp *restful.Response) {
+		_ = resp.WriteAsJson(doc)
+	}))
+	return ws
+}
+
+// BuildSwagger builds the swagger document of the container without serving it,
+// which is the same as the one served by NewSwaggerService with info.
+func (c *Container) BuildSwagger(info SwaggerInfo) (*spec.Swagger, error) {
+	return buildSwagger(c, info)
+}
+
+// BuildSwagger builds the swagger document of default container without serving it.
+func BuildSwagger(info SwaggerInfo) (*spec.Swagger, error) {
+	return buildSwagger(DefaultContainer, info)
+}
+
+// BuildOpenAPI builds the OpenAPI 3.1 document of the container without serving it,
+// which is the same as the one served by NewOpenAPIService with info.
+func (c *Container) BuildOpenAPI(info SwaggerInfo) (*openapi.Document, error) {
+	return buildOpenAPI(c, info)
+}
+
+// BuildOpenAPI builds the OpenAPI 3.1 document of default container without serving it.
+func BuildOpenAPI(info SwaggerInfo) (*openapi.Document, error) {
+	return buildOpenAPI(DefaultContainer, info)
+}
+
+// buildSwagger builds the swagger document of container,
+// an error is returned if info does not match the routes or the document can not be encoded,
+// e.g. an example is not JSON.
+func buildSwagger(container *Container, info SwaggerInfo) (*spec.Swagger, error) {
+	if err := checkInfo(container, info); err != nil {
+		return nil, err
+	}
+	swo := restfulspec.BuildSwagger(swaggerConfig(container, &info, "swagger"))
+	if _, err := json.Marshal(swo); err != nil {
+		return nil, fmt.Errorf("encode swagger: %w", err)
+	}
+	return swo, nil
+}
+
+func buildOpenAPI(container *Container, info SwaggerInfo) (*openapi.Document, error) {
+	swo, err := buildSwagger(container, info)
+	if err != nil {
+		return nil, err
+	}
+	doc := openapi.FromSwagger(swo)
+	if _, err := json.Marshal(doc); err != nil {
+		return nil, fmt.Errorf("encode openapi: %w", err)
+	}
+	return doc, nil
+}
+
+// ValidateExamples checks the examples of routes against their schemas in the OpenAPI document,
+// it is meant to be called in tests to keep the examples in sync with the API.
+func (c *Container) ValidateExamples() error {
+	return validateExamples(c)
+}
+
+// ValidateExamples checks the examples of routes against their schemas in the OpenAPI document,
+// it is meant to be called in tests to keep the examples in sync with the API.
+func ValidateExamples() error {
+	return validateExamples(DefaultContainer)
+}
+
+func validateExamples(container *Container) error {
+	doc, err := buildOpenAPI(container, SwaggerInfo{internal: true})
+	if err != nil {
+		return err
+	}
+	return doc.ValidateExamples()
+}
+
+// swaggerConfig normalizes the routes of info and returns the config of documents,
+// suffix is the default RouteSuffix.
+func swaggerConfig(container *Container, info *SwaggerInfo, suffix string) restfulspec.Config {
 	if info.RouteSuffix == "" {
-		info.RouteSuffix = "swagger"
+		info.RouteSuffix = suffix
 	}
 	if info.RoutePrefix != "" {
 		info.RoutePrefix = "/" + strings.Trim(info.RoutePrefix, "/")
 	}
 	info.RouteSuffix = "/" + strings.Trim(info.RouteSuffix, "/")
-	config := restfulspec.Config{
+	return restfulspec.Config{
 		WebServices:                   container.RegisteredWebServices(),
 		APIPath:                       info.RoutePrefix + info.RouteSuffix + ".json",
 		DisableCORS:                   info.DisableCORS,
 		WebServicesURL:                info.WebServicesURL,
-		PostBuildSwaggerObjectHandler: enrichSwaggerObject(container, info, container.ServeMux),
+		PostBuildSwaggerObjectHandler: enrichSwaggerObject(container, *info),
+	}
+}
+
+var docsPages = template.Must(template.ParseFS(swagger,
+	"swagger/index.html", "swagger/redoc.html", "swagger/scalar.html"))
+
+// docsUIs are the templates and default assets of the document pages,
+// the assets are pinned to exact versions so the pages do not change with new releases.
+var docsUIs = map[DocsUI]struct {
+	page   string
+	assets string
+}{
+	DocsUISwagger: {page: "index.html"},
+	DocsUIReDoc:   {page: "redoc.html", assets: "https://cdn.jsdelivr.net/npm/redoc@2.4.0/bundles/redoc.standalone.js"},
+	DocsUIScalar:  {page: "scalar.html", assets: "https://cdn.jsdelivr.net/npm/@scalar/api-reference@1.34.0/dist/browser/standalone.js"},
+}
+
+// docsPage is the data of a document page.
+type docsPage struct {
+	SwaggerInfo
+	Assets    string
+	Integrity string
+}
+
+// serveSwaggerUI serves the document page of info.UI in route,
+// which shows the document in route + ".json" or info.Specs,
+// and the documents of groups in route + "/<path>.json".
+func serveSwaggerUI(container *Container, route string, info SwaggerInfo, docs map[string]interface{}) {
+	if info.UI == "" {
+		info.UI = DocsUISwagger
+	}
+	ui, ok := docsUIs[info.UI]
+	if !ok {
+		container.logger.Info(log.BiuInternalInfo{
+			Err: fmt.Errorf("unknown docs ui %q, %q is used instead", info.UI, DocsUISwagger),
+		})
+		ui = docsUIs[DocsUISwagger]
+	}
+	page := docsPage{SwaggerInfo: info, Assets: ui.assets, Integrity: info.UIAssetsIntegrity}
+	if info.UIAssetsURL != "" {
+		page.Assets = info.UIAssetsURL
 	}
-	route := info.RoutePrefix + info.RouteSuffix
 	container.ServeMux.Handle(route+"/",
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if p := strings.TrimPrefix(r.URL.Path, route); len(p) < len(r.URL.Path) {
-				r2 := new(http.Request)
-				*r2 = *r
-				r2.URL = new(url.URL)
-				*r2.URL = *r.URL
-				r2.URL.Path = "swagger" + p
-				http.FileServer(http.FS(swagger)).ServeHTTP(w, r2)
-			} else {
+			p := strings.TrimPrefix(r.URL.Path, route)
+			if len(p) == len(r.URL.Path) {
+				http.NotFound(w, r)
+				return
+			}
+			if doc, ok := docs[strings.TrimSuffix(p[1:], ".json")]; ok && strings.HasSuffix(p, ".json") {
+				if origin := r.Header.Get(restful.HEADER_Origin); origin != "" && !info.DisableCORS {
+					w.Header().Set(restful.HEADER_AccessControlAllowOrigin, origin)
+				}
+				w.Header().Set("Content-Type", restful.MIME_JSON)
+				_ = json.NewEncoder(w).Encode(doc)
+				return
+			}
+			switch p {
+			case "/", "/index.html":
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				_ = docsPages.ExecuteTemplate(w, ui.page, page)
+				return
+			case "/redoc.html", "/scalar.html":
 				http.NotFound(w, r)
+				return
 			}
+			r2 := new(http.Request)
+			*r2 = *r
+			r2.URL = new(url.URL)
+			*r2.URL = *r.URL
+			r2.URL.Path = "swagger" + p
+			http.FileServer(http.FS(swagger)).ServeHTTP(w, r2)
 		}),
 	)
-	return restfulspec.NewOpenAPIService(config)
 }
 
-func enrichSwaggerObject(container *Container, info SwaggerInfo, serveMux *http.ServeMux) func(swo *spec.Swagger) {
+func enableCORS(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if origin := req.HeaderParameter(restful.HEADER_Origin); origin != "" {
+		// prevent duplicate header
+		if len(resp.Header().Get(restful.HEADER_AccessControlAllowOrigin)) == 0 {
+			resp.AddHeader(restful.HEADER_AccessControlAllowOrigin, origin)
+		}
+	}
+	chain.ProcessFilter(req, resp)
+}
+
+func enrichSwaggerObject(container *Container, info SwaggerInfo) func(swo *spec.Swagger) {
 	return func(swo *spec.Swagger) {
 		contact := &spec.ContactInfo{
 			ContactInfoProps: spec.ContactInfoProps{
@@ -86,31 +281,385 @@ func enrichSwaggerObject(container *Container, info SwaggerInfo, serveMux *http.
 		swo.Info = &spec.Info{
 			InfoProps: infoProps,
 		}
-		swo.Tags = container.swaggerTags[serveMux]
-		swo.SecurityDefinitions = map[string]*spec.SecurityScheme{
-			"jwt": spec.APIKeyAuth("Authorization", "header"),
+		swo.Tags = append([]spec.Tag(nil), container.swaggerTags...)
+		if len(info.SecuritySchemes) > 0 {
+			swo.SecurityDefinitions = make(spec.SecurityDefinitions, len(info.SecuritySchemes))
+			for name, scheme := range info.SecuritySchemes {
+				swo.SecurityDefinitions[name] = scheme
+			}
+		}
+		if len(container.errors) > 0 {
+			swo.AddExtension(openapi.ExtCodes, errorCodes(container.errors))
 		}
+		removed := false
 		for _, ws := range container.RegisteredWebServices() {
 			for _, route := range ws.Routes() {
 				processAuth(swo, route)
+				processDownload(swo, route)
+				processCookies(swo, route)
+				processDefaults(swo, route)
+				processEnvelope(container, swo, route)
+				processExamples(swo, route)
+				if processHidden(swo, route) || processGroups(swo, route, info.Groups) {
+					removed = true
+				}
 			}
 		}
+		if len(info.Groups) > 0 {
+			pruneTags(swo)
+		}
+		if removed {
+			pruneDefinitions(swo)
+		}
 	}
 }
 
+// processEnvelope wraps the schemas of success responses in box.CommonResp,
+// which is the format responded by DefaultResponseTransformer,
+// and documents the business errors of DefaultErrorTransformer as the default response.
+// Nothing is changed if the container is not created by New.
+func processEnvelope(container *Container, swo *spec.Swagger, route restful.Route) {
+	pOption := getPathOption(swo, route)
+	if pOption == nil || !container.envelope {
+		return
+	}
+	if _, ok := pOption.Extensions[openapi.ExtCodes]; ok || swo.Extensions[openapi.ExtCodes] != nil {
+		if pOption.Responses == nil {
+			pOption.Responses = &spec.Responses{}
+		}
+		if pOption.Responses.Default == nil {
+			addDefinition(swo, errorEnvelopeName, errorEnvelopeSchema())
+			pOption.Responses.Default = spec.NewResponse().
+				WithDescription("business error, the code is one of " + openapi.ExtCodes).
+				WithSchema(spec.RefSchema("#/definitions/" + errorEnvelopeName))
+		}
+	}
+	if _, ok := route.Metadata[metaNoEnvelope]; ok || pOption.Responses == nil {
+		return
+	}
+	for code, resp := range pOption.Responses.StatusCodeResponses {
+		if code < 200 || code >= 300 || resp.Schema == nil || resp.Schema.Type.Contains("file") {
+			continue
+		}
+		name := "box.CommonResp[" + envelopeDataName(resp.Schema) + "]"
+		addDefinition(swo, name, envelopeSchema(*resp.Schema))
+		resp.Schema = spec.RefSchema("#/definitions/" + name)
+		pOption.Responses.StatusCodeResponses[code] = resp
+	}
+}
+
+// errorEnvelopeName is the definition of the errors responded by DefaultErrorTransformer.
+const errorEnvelopeName = "box.CommonResp"
+
+// addDefinition adds the schema as name if it is not defined.
+func addDefinition(swo *spec.Swagger, name string, schema spec.Schema) {
+	if _, ok := swo.Definitions[name]; ok {
+		return
+	}
+	if swo.Definitions == nil {
+		swo.Definitions = make(spec.Definitions)
+	}
+	swo.Definitions[name] = schema
+}
+
+// envelopeDataName names the data of an envelope in the Go syntax.
+func envelopeDataName(schema *spec.Schema) string {
+	if ref := schema.Ref.String(); ref != "" {
+		name, err := url.PathUnescape(strings.TrimPrefix(ref, "#/definitions/"))
+		if err != nil {
+			return ref
+		}
+		return name
+	}
+	if schema.Type.Contains("array") && schema.Items != nil && schema.Items.Schema != nil {
+		return "[]" + envelopeDataName(schema.Items.Schema)
+	}
+	if len(schema.Type) > 0 {
+		return schema.Type[0]
+	}
+	return "object"
+}
+
+func envelopeSchema(data spec.Schema) spec.Schema {
+	schema := errorEnvelopeSchema()
+	schema.Properties["data"] = data
+	schema.Required = append(schema.Required, "data")
+	return schema
+}
+
+// errorEnvelopeSchema is the schema of box.CommonResp, whose data is optional.
+func errorEnvelopeSchema() spec.Schema {
+	code := spec.Int64Property()
+	code.Format = ""
+	code.Description = "0 for success, business error codes are listed in " + openapi.ExtCodes
+	return spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:     spec.StringOrArray{"object"},
+		Required: []string{"code", "message"},
+		Properties: spec.SchemaProperties{
+			"code":     *code,
+			"message":  *spec.StringProperty(),
+			"data":     {},
+			"route_id": *spec.StringProperty(),
+		},
+	}}
+}
+
+// processAuth documents the security requirements of route,
+// the jwt scheme is defined when it is used by opt.EnableAuth.
 func processAuth(swo *spec.Swagger, route restful.Route) {
-	_, ok := route.Metadata["jwt"]
+	pOption := getPathOption(swo, route)
+	if pOption == nil {
+		return
+	}
+	if _, ok := route.Metadata[metaJWT]; ok {
+		addJWTScheme(swo)
+		pOption.Security = append(pOption.Security, map[string][]string{metaJWT: {}})
+	}
+	requirements, _ := route.Metadata[metaSecurity].([]map[string][]string)
+	for _, requirement := range requirements {
+		for name := range requirement {
+			if name == metaJWT {
+				addJWTScheme(swo)
+			}
+		}
+	}
+	pOption.Security = append(pOption.Security, requirements...)
+}
+
+// checkInfo reports the mistakes of info which make the documents of container invalid,
+// e.g. a security scheme used by opt.Security is not in info.SecuritySchemes,
+// or the path of a doc group is invalid.
+func checkInfo(container *Container, info SwaggerInfo) error {
+	errs := checkGroups(info.Groups)
+	if info.internal {
+		return errors.Join(errs...)
+	}
+	for _, ws := range container.RegisteredWebServices() {
+		for _, route := range ws.Routes() {
+			requirements, _ := route.Metadata[metaSecurity].([]map[string][]string)
+			for _, requirement := range requirements {
+				for name := range requirement {
+					if _, ok := info.SecuritySchemes[name]; ok || name == metaJWT {
+						continue
+					}
+					errs = append(errs, fmt.Errorf("security scheme %q of %s %s is not in SwaggerInfo.SecuritySchemes",
+						name, route.Method, route.Path))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// addJWTScheme defines the jwt scheme of opt.EnableAuth if it is not defined.
+func addJWTScheme(swo *spec.Swagger) {
+	if _, ok := swo.SecurityDefinitions[metaJWT]; ok {
+		return
+	}
+	if swo.SecurityDefinitions == nil {
+		swo.SecurityDefinitions = make(spec.SecurityDefinitions)
+	}
+	swo.SecurityDefinitions[metaJWT] = spec.APIKeyAuth("Authorization", "header")
+}
+
+// processHidden removes the operation of a hidden route,
+// it reports whether the route is hidden.
+func processHidden(swo *spec.Swagger, route restful.Route) bool {
+	if _, ok := route.Metadata[metaHidden]; !ok {
+		return false
+	}
+	removeOperation(swo, route)
+	return true
+}
+
+// removeOperation removes the operation of route,
+// and the path if it has no other operations.
+func removeOperation(swo *spec.Swagger, route restful.Route) {
+	if swo.Paths == nil {
+		return
+	}
+	p := docPath(route.Path)
+	item, ok := swo.Paths.Paths[p]
 	if !ok {
 		return
 	}
+	switch strings.ToLower(route.Method) {
+	case "get":
+		item.Get = nil
+	case "post":
+		item.Post = nil
+	case "patch":
+		item.Patch = nil
+	case "delete":
+		item.Delete = nil
+	case "put":
+		item.Put = nil
+	case "head":
+		item.Head = nil
+	case "options":
+		item.Options = nil
+	}
+	if item.Get == nil && item.Post == nil && item.Patch == nil && item.Delete == nil &&
+		item.Put == nil && item.Head == nil && item.Options == nil {
+		delete(swo.Paths.Paths, p)
+		return
+	}
+	swo.Paths.Paths[p] = item
+}
+
+// processDownload documents the responses of box.Download as files in their statuses.
+func processDownload(swo *spec.Swagger, route restful.Route) {
+	statuses, _ := route.Metadata[metaDownload].([]int)
+	pOption := getPathOption(swo, route)
+	if len(statuses) == 0 || pOption == nil || pOption.Responses == nil {
+		return
+	}
+	for _, status := range statuses {
+		resp, ok := pOption.Responses.StatusCodeResponses[status]
+		if !ok {
+			continue
+		}
+		resp.Schema = &spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"file"}}}
+		pOption.Responses.StatusCodeResponses[status] = resp
+	}
+}
+
+// processCookies appends the note of cookie parameters to the description of route,
+// since swagger ui does not show the extension they are listed in.
+func processCookies(swo *spec.Swagger, route restful.Route) {
+	pOption := getPathOption(swo, route)
+	if pOption == nil {
+		return
+	}
+	note := openapi.CookieNote(pOption.Extensions[openapi.ExtCookies])
+	if note == "" {
+		return
+	}
+	if pOption.Description != "" {
+		pOption.Description += "\n\n"
+	}
+	pOption.Description += note
+}
+
+// processDefaults converts the default values of parameters to their types,
+// which are guessed from strings by restfulspec.
+func processDefaults(swo *spec.Swagger, route restful.Route) {
 	pOption := getPathOption(swo, route)
-	if pOption != nil {
-		pOption.SecuredWith("jwt")
+	if pOption == nil {
+		return
+	}
+	for i, p := range pOption.Parameters {
+		if p.Default == nil || p.In == "body" {
+			continue
+		}
+		pOption.Parameters[i].Default = typedParam(fmt.Sprint(p.Default), p)
+	}
+}
+
+// typedParam converts s to the type of parameter p,
+// values of arrays are separated by comma.
+func typedParam(s string, p spec.Parameter) interface{} {
+	if p.Type != "array" || p.Items == nil {
+		return typedDefault(s, p.Type)
+	}
+	var items []interface{}
+	for _, v := range strings.Split(s, ",") {
+		items = append(items, typedDefault(v, p.Items.Type))
+	}
+	return items
+}
+
+// processExamples documents the examples of parameters in their types,
+// and the examples of body and returns from opt.Exampler.
+// Swagger 2 allows one example of a media type, which is the first by name,
+// all of them are kept in an extension for OpenAPI 3.
+func processExamples(swo *spec.Swagger, route restful.Route) {
+	pOption := getPathOption(swo, route)
+	if pOption == nil {
+		return
+	}
+	examples, _ := route.Metadata[metaExamples].(routeExamples)
+	for i, p := range pOption.Parameters {
+		if p.In == "body" {
+			if len(examples.body) > 0 {
+				pOption.Parameters[i].AddExtension(openapi.ExtExamples,
+					map[string]interface{}{restful.MIME_JSON: firstExample(examples.body)})
+				pOption.Parameters[i].AddExtension(openapi.ExtNamedExamples, examples.body)
+			}
+			continue
+		}
+		if v, ok := p.Extensions[openapi.ExtExample]; ok {
+			pOption.Parameters[i].Extensions[openapi.ExtExample] = typedParam(fmt.Sprint(v), p)
+		}
+	}
+	if pOption.Responses == nil {
+		return
+	}
+	for code, named := range examples.returns {
+		resp, ok := pOption.Responses.StatusCodeResponses[code]
+		if !ok {
+			continue
+		}
+		if resp.Schema != nil && strings.HasPrefix(resp.Schema.Ref.String(), "#/definitions/box.CommonResp") {
+			enveloped := make(map[string]interface{}, len(named))
+			for name, v := range named {
+				enveloped[name] = box.CommonResp{Data: v}
+			}
+			named = enveloped
+		}
+		resp.Examples = map[string]interface{}{restful.MIME_JSON: firstExample(named)}
+		resp.AddExtension(openapi.ExtNamedExamples, named)
+		pOption.Responses.StatusCodeResponses[code] = resp
+	}
+}
+
+// firstExample returns the example with the first name in order.
+func firstExample(named map[string]interface{}) interface{} {
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return named[names[0]]
+}
+
+func typedDefault(s, typ string) interface{} {
+	switch typ {
+	case "integer":
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return v
+		}
+	case "number":
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			return v
+		}
+	case "boolean":
+		if v, err := strconv.ParseBool(s); err == nil {
+			return v
+		}
+	}
+	return s
+}
+
+// docPath returns the path of a route in documents, the regex expressions
+// of path parameters are removed the same as restfulspec,
+// e.g. "/users/{id:[0-9]+}/" is documented as "/users/{id}".
+func docPath(routePath string) string {
+	var b strings.Builder
+	for _, fragment := range strings.Split(routePath, "/") {
+		if fragment == "" {
+			continue
+		}
+		if strings.HasPrefix(fragment, "{") && strings.Contains(fragment, ":") {
+			fragment = fragment[:strings.Index(fragment, ":")] + "}"
+		}
+		b.WriteString("/" + fragment)
 	}
+	return b.String()
 }
 
 func getPathOption(swo *spec.Swagger, route restful.Route) *spec.Operation {
-	p, err := swo.Paths.JSONLookup(strings.TrimRight(route.Path, "/"))
+	p, err := swo.Paths.JSONLookup(docPath(route.Path))
 	if err != nil {
 		return nil
 	}
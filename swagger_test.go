@@ -1,10 +1,20 @@
 package biu_test
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/emicklei/go-restful/v3"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/go-openapi/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
 	"github.com/tuotoo/biu/opt"
 )
 
@@ -42,3 +52,718 @@ func TestNewSwaggerService(t *testing.T) {
 	route := routes[0]
 	assert.Equal(t, "GET /v1/swagger.json", route.String())
 }
+
+// swaggerJSON serves the swagger document of container and returns it.
+func swaggerJSON(t *testing.T, c *biu.Container) *httpexpect.Object {
+	c.Add(c.NewSwaggerService(biu.SwaggerInfo{}))
+	return httpexpect.Default(t, swaggerURL(t, c)).GET("/swagger.json").Expect().JSON().Object()
+}
+
+func swaggerURL(t *testing.T, c *biu.Container) string {
+	s := httptest.NewServer(c)
+	t.Cleanup(s.Close)
+	return s.URL
+}
+
+type responseTypeCtl struct{}
+
+func (ctl responseTypeCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/events"), opt.EventStream(), opt.RouteTo(func(ctx box.Ctx) {}))
+	ws.Route(ws.GET("/file"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(box.Download) `desc:"the file"`
+	}) {
+		api.Return(box.Download{Name: "a.txt", Content: strings.NewReader("a")})
+	}))
+	ws.Route(ws.GET("/range"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(box.Download) `status:"206" desc:"the range"`
+	}) {
+	}))
+}
+
+type returnCtl struct{}
+
+func (ctl returnCtl) WebService(ws biu.WS) {
+	ws.Route(ws.POST("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return         func(string, box.RespMeta) `status:"201" headers:"Location,ETag" desc:"created"`
+		ReturnAccepted func(int)                  `status:"202" desc:"accepted"`
+	}) {
+	}))
+}
+
+func TestSwaggerReturnStatus(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "return", Controller: returnCtl{}})
+	doc := swaggerJSON(t, c)
+	responses := doc.Value("paths").Object().Value("/return").Object().
+		Value("post").Object().Value("responses").Object()
+	responses.Keys().ContainsOnly("201", "202")
+	created := responses.Value("201").Object()
+	created.HasValue("description", "created")
+	created.Path("$.schema").Object().Value("$ref").IsEqual("#/definitions/box.CommonResp%5Bstring%5D")
+	doc.Value("definitions").Object().Value("box.CommonResp[string]").Path("$.properties.data.type").IsEqual("string")
+	created.Value("headers").Object().Keys().ContainsOnly("Location", "ETag")
+	responses.Value("202").Object().HasValue("description", "accepted")
+}
+
+func TestSwaggerResponseTypes(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "resp", Controller: responseTypeCtl{}})
+	paths := swaggerJSON(t, c).Value("paths").Object()
+	paths.Value("/resp/events").Path("$.get.produces").Array().IsEqual([]string{biu.MIME_EVENT_STREAM})
+	file := paths.Value("/resp/file").Object().Value("get").Object()
+	file.Value("produces").Array().ContainsAll("application/octet-stream")
+	ok := file.Value("responses").Object().Value("200").Object()
+	ok.Path("$.schema.type").IsEqual("file")
+	ok.Value("description").IsEqual("the file")
+	ranged := paths.Value("/resp/range").Path("$.get.responses").Object()
+	ranged.Keys().ContainsOnly("206")
+	ranged.Value("206").Path("$.schema.type").IsEqual("file")
+}
+
+type hexID uint64
+
+func (id *hexID) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 16, 64)
+	*id = hexID(v)
+	return err
+}
+
+func (id hexID) SwaggerType() (typ, format string) {
+	return "string", "hex"
+}
+
+type upper string
+
+func (u *upper) UnmarshalText(text []byte) error {
+	*u = upper(strings.ToUpper(string(text)))
+	return nil
+}
+
+type Pagination struct {
+	Page int
+}
+
+type constraintCtl struct{}
+
+func (ctl constraintCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			Page int    `biu:"required;min:1;max:10"`
+			Sort string `biu:"enum:asc,desc;pattern:^[a-z]+$;minLength:3;maxLength:4"`
+			Name string `validate:"required"`
+		}
+	}) {
+	}))
+	ws.Route(ws.GET("/text/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			ID hexID
+		}
+		Query struct {
+			Names []upper
+		}
+	}) {
+	}))
+	ws.Route(ws.GET("/nested"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			Pagination
+			Filter struct {
+				Status string
+			}
+			Sort struct {
+				By string
+			} `biu:"style:dot"`
+			Meta map[string]string
+		}
+	}) {
+	}))
+	ws.Route(ws.GET("/cookie"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Cookie struct {
+			Session string `biu:"required;desc:session id"`
+			Theme   string
+		}
+	}) {
+	}))
+	ws.Route(ws.GET("/defaults"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			Limit int      `biu:"default:20"`
+			Code  string   `biu:"default:1"`
+			Tags  []int    `biu:"default:1,2"`
+			Rate  float64  `biu:"default:0.5"`
+			Names []string `biu:"default:a,b"`
+		}
+	}) {
+	}))
+}
+
+func TestSwaggerConstraints(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "constraint", Controller: constraintCtl{}})
+	params := swaggerJSON(t, c).Value("paths").Object().Value("/constraint").Object().
+		Value("get").Object().Value("parameters").Array()
+	params.Value(0).Object().ContainsSubset(map[string]interface{}{
+		"name":     "page",
+		"required": true,
+		"minimum":  1,
+		"maximum":  10,
+	})
+	params.Value(1).Object().ContainsSubset(map[string]interface{}{
+		"name":      "sort",
+		"enum":      []string{"asc", "desc"},
+		"pattern":   "^[a-z]+$",
+		"minLength": 3,
+		"maxLength": 4,
+	})
+	params.Value(2).Object().ContainsSubset(map[string]interface{}{
+		"name":     "name",
+		"required": true,
+	})
+}
+
+func TestSwaggerDefaults(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "constraint", Controller: constraintCtl{}})
+	params := swaggerJSON(t, c).Value("paths").Object().Value("/constraint/defaults").Object().
+		Value("get").Object().Value("parameters").Array()
+	params.Path("$[*].default").Array().IsEqual([]interface{}{20, "1", []int{1, 2}, 0.5, []string{"a", "b"}})
+}
+
+func TestSwaggerTextUnmarshaler(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "constraint", Controller: constraintCtl{}})
+	params := swaggerJSON(t, c).Value("paths").Object().Value("/constraint/text/{id}").Object().
+		Value("get").Object().Value("parameters").Array()
+	params.Value(0).Object().ContainsSubset(map[string]interface{}{
+		"name":   "id",
+		"type":   "string",
+		"format": "hex",
+	})
+	params.Value(1).Object().ContainsSubset(map[string]interface{}{
+		"name":  "names",
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string"},
+	})
+}
+
+func TestSwaggerNestedParams(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "constraint", Controller: constraintCtl{}})
+	params := swaggerJSON(t, c).Value("paths").Object().Value("/constraint/nested").Object().
+		Value("get").Object().Value("parameters").Array()
+	params.Path("$[*].name").Array().IsEqual([]string{"page", "filter[status]", "sort.by", "meta[key]"})
+}
+
+func TestSwaggerCookieParams(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "constraint", Controller: constraintCtl{}})
+	get := swaggerJSON(t, c).Value("paths").Object().Value("/constraint/cookie").Object().
+		Value("get").Object()
+	get.NotContainsKey("parameters")
+	cookies := get.Value("x-biu-cookies").Array()
+	cookies.Path("$[*].name").Array().IsEqual([]string{"session", "theme"})
+	cookies.Value(0).Object().ContainsSubset(map[string]interface{}{
+		"description": "session id",
+		"required":    true,
+	})
+	get.Value("description").String().HasSuffix("**Cookies**\n\n- `session` (string, required): session id\n- `theme` (string)")
+	doc, err := c.BuildOpenAPI(biu.SwaggerInfo{})
+	assert.NoError(t, err)
+	op := doc.Paths["/constraint/cookie"]["get"]
+	assert.NotContains(t, op.Description, "Cookies")
+	assert.Len(t, op.Parameters, 2)
+}
+
+type handleReq struct {
+	Path struct {
+		ID int `biu:"desc:user id"`
+	}
+	Query struct {
+		Fields []string `biu:"enum:name,age"`
+	}
+	Body struct {
+		Name string `json:"name"`
+	}
+}
+
+type handleResp struct {
+	ID int `json:"id"`
+}
+
+type handleCtl struct{}
+
+func (ctl handleCtl) WebService(ws biu.WS) {
+	ws.Route(ws.PUT("/handle/{id}"), opt.Handle(func(ctx box.Ctx, req handleReq) (handleResp, error) {
+		return handleResp{}, nil
+	}))
+	ws.Route(ws.PUT("/api/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		handleReq
+		Return func(handleResp)
+	}) {
+	}))
+}
+
+func TestSwaggerHandle(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "generic", Controller: handleCtl{}})
+	paths := swaggerJSON(t, c).Value("paths").Object()
+	handle := paths.Value("/generic/handle/{id}").Object().Value("put").Object()
+	api := paths.Value("/generic/api/{id}").Object().Value("put").Object()
+	handle.Value("parameters").Path("$[*].name").Array().ContainsAll("id", "fields", "body")
+	handle.Value("parameters").IsEqual(api.Value("parameters").Raw())
+	handle.Value("responses").IsEqual(api.Value("responses").Raw())
+}
+
+type openAPICtl struct{}
+
+func (ctl openAPICtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/auth"), opt.EnableAuth())
+	ws.Route(ws.POST("/upload"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Form struct {
+			File box.File `biu:"required"`
+			Note string
+		}
+	}) {
+	}))
+}
+
+func TestOpenAPIService(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil,
+		biu.NS{NameSpace: "constraint", Controller: constraintCtl{}},
+		biu.NS{NameSpace: "generic", Controller: handleCtl{}},
+		biu.NS{NameSpace: "oas", Controller: openAPICtl{}, Desc: "oas routes"},
+	)
+	c.Add(c.NewOpenAPIService(biu.SwaggerInfo{Title: "title"}))
+	s := httptest.NewServer(c)
+	defer s.Close()
+	e := httpexpect.Default(t, s.URL)
+
+	e.GET("/openapi/").Expect().Status(http.StatusOK).Body().Contains("swagger-ui")
+	doc := e.GET("/openapi.json").Expect().Status(http.StatusOK).JSON().Object()
+	doc.HasValue("openapi", "3.1.0")
+	doc.Path("$.info.title").IsEqual("title")
+	doc.Value("tags").Array().Path("$[*].name").Array().ContainsAll("oas")
+	doc.Path("$.components.securitySchemes.jwt").Object().
+		ContainsSubset(map[string]interface{}{"type": "apiKey", "in": "header", "name": "Authorization"})
+	paths := doc.Value("paths").Object()
+
+	paths.Value("/oas/auth").Path("$.get.security").IsEqual([]map[string][]string{{"jwt": {}}})
+
+	cookies := paths.Value("/constraint/cookie").Path("$.get.parameters").Array()
+	cookies.Path("$[*].in").Array().IsEqual([]string{"cookie", "cookie"})
+	cookies.Value(0).Object().ContainsSubset(map[string]interface{}{
+		"name":        "session",
+		"required":    true,
+		"description": "session id",
+		"schema":      map[string]interface{}{"type": "string"},
+	})
+
+	nested := paths.Value("/constraint/nested").Path("$.get.parameters").Array()
+	nested.Path("$[*].name").Array().IsEqual([]string{"page", "filter[status]", "sort.by", "meta"})
+	nested.Value(3).Object().ContainsSubset(map[string]interface{}{
+		"in":      "query",
+		"style":   "deepObject",
+		"explode": true,
+		"schema": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": map[string]interface{}{"type": "string"},
+		},
+	})
+	paths.Value("/constraint").Path("$.get.parameters[0].schema").Object().
+		ContainsSubset(map[string]interface{}{"type": "integer", "minimum": 1, "maximum": 10})
+
+	handle := paths.Value("/generic/handle/{id}").Path("$.put").Object()
+	handle.Value("parameters").Array().Path("$[*].name").Array().NotContainsAll("body")
+	ref := handle.Path("$.requestBody.content").Object().Value(restful.MIME_JSON).Path("$.schema").Object().Value("$ref").String()
+	ref.HasPrefix("#/components/schemas/")
+	name, err := url.PathUnescape(strings.TrimPrefix(ref.Raw(), "#/components/schemas/"))
+	assert.NoError(t, err)
+	doc.Path("$.components.schemas").Object().ContainsKey(name)
+	handle.Path("$.responses").Object().Value("200").Path("$.content").Object().Value(restful.MIME_JSON).
+		Path("$.schema").Object().Value("$ref").String().
+		HasPrefix("#/components/schemas/")
+
+	upload := paths.Value("/oas/upload").Path("$.post.requestBody").Object()
+	upload.HasValue("required", true)
+	upload.Path("$.content").Object().Value(biu.MIME_FILE_FORM).Path("$.schema").Object().ContainsSubset(map[string]interface{}{
+		"type":     "object",
+		"required": []string{"file"},
+		"properties": map[string]interface{}{
+			"file": map[string]interface{}{"type": "string", "format": "binary"},
+			"note": map[string]interface{}{"type": "string"},
+		},
+	})
+}
+
+type envelopeCtl struct{}
+
+func (ctl envelopeCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/{id}"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(handleResp)
+	}) {
+	}), opt.RouteErrors(map[int]string{1001: "not found"}))
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func([]handleResp)
+	}) {
+	}))
+	ws.Route(ws.GET("/raw"), opt.NoEnvelope(), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(handleResp)
+	}) {
+		api.Return(handleResp{})
+	}))
+}
+
+func TestSwaggerEnvelope(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", opt.ServicesFuncArr{opt.ServiceErrors(map[int]string{1: "unknown"})},
+		biu.NS{NameSpace: "envelope", Controller: envelopeCtl{}})
+	c.Add(c.NewOpenAPIService(biu.SwaggerInfo{}))
+	doc := swaggerJSON(t, c)
+	doc.Value("x-codes").IsEqual(map[string]string{"1": "unknown"})
+	paths := doc.Value("paths").Object()
+
+	get := paths.Value("/envelope/{id}").Path("$.get").Object()
+	get.Value("x-codes").IsEqual(map[string]string{"1001": "not found"})
+	get.Value("responses").Object().Keys().ContainsOnly("200", "default")
+	get.Path("$.responses.default.schema").Object().Value("$ref").IsEqual("#/definitions/box.CommonResp")
+	get.Path("$.responses").Object().Value("200").Path("$.schema").Object().
+		Value("$ref").IsEqual("#/definitions/box.CommonResp%5Bbiu_test.handleResp%5D")
+	paths.Value("/envelope").Path("$.get.responses").Object().Value("200").Path("$.schema").Object().
+		Value("$ref").IsEqual("#/definitions/box.CommonResp%5B%5B%5Dbiu_test.handleResp%5D")
+
+	definitions := doc.Value("definitions").Object()
+	envelope := definitions.Value("box.CommonResp[biu_test.handleResp]").Object()
+	envelope.Value("required").Array().IsEqual([]string{"code", "message", "data"})
+	envelope.Value("properties").Object().Keys().ContainsOnly("code", "message", "data", "route_id")
+	envelope.Path("$.properties.data").Object().Value("$ref").IsEqual("#/definitions/biu_test.handleResp")
+	definitions.Value("box.CommonResp[[]biu_test.handleResp]").Path("$.properties.data.type").IsEqual("array")
+	definitions.Value("box.CommonResp").Path("$.required").IsEqual([]string{"code", "message"})
+	paths.Value("/envelope/raw").Path("$.get.responses").Object().Value("200").Path("$.schema").Object().
+		Value("$ref").IsEqual("#/definitions/biu_test.handleResp")
+
+	oas := httpexpect.Default(t, swaggerURL(t, c)).GET("/openapi.json").Expect().JSON().Object()
+	oas.Value("x-codes").IsEqual(map[string]string{"1": "unknown"})
+	oas.Value("paths").Object().Value("/envelope/{id}").Path("$.get").Object().
+		Value("x-codes").IsEqual(map[string]string{"1001": "not found"})
+	httpexpect.Default(t, swaggerURL(t, c)).GET("/envelope/raw").Expect().JSON().Object().
+		NotContainsKey("code").ContainsKey("id")
+
+	plain := biu.NewContainer()
+	plain.AddServices("", nil, biu.NS{NameSpace: "envelope", Controller: envelopeCtl{}})
+	doc = swaggerJSON(t, plain)
+	responses := doc.Value("paths").Object().Value("/envelope/{id}").Path("$.get.responses").Object()
+	responses.Keys().ContainsOnly("200")
+	responses.Value("200").Path("$.schema").Object().Value("$ref").IsEqual("#/definitions/biu_test.handleResp")
+}
+
+type securityCtl struct{}
+
+func (ctl securityCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.Security("oauth", "read"), opt.Security("key"))
+	ws.Route(ws.POST("/"), opt.EnableAuth(), opt.Security("basic"))
+	ws.Route(ws.GET("/public"))
+}
+
+func TestSwaggerSecurity(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "security", Controller: securityCtl{}})
+	oauth := spec.OAuth2AccessToken("/oauth/authorize", "/oauth/token")
+	oauth.AddScope("read", "read access")
+	info := biu.SwaggerInfo{
+		SecuritySchemes: map[string]*spec.SecurityScheme{
+			"oauth": oauth,
+			"basic": spec.BasicAuth(),
+			"key":   spec.APIKeyAuth("api_key", "query"),
+		},
+		OAuthClientID: "client",
+		OAuthUsePKCE:  true,
+	}
+	c.Add(c.NewSwaggerService(info))
+	c.Add(c.NewOpenAPIService(info))
+	e := httpexpect.Default(t, swaggerURL(t, c))
+	doc := e.GET("/swagger.json").Expect().JSON().Object()
+	doc.Value("securityDefinitions").Object().Keys().ContainsOnly("oauth", "basic", "key", "jwt")
+	doc.Path("$.securityDefinitions.oauth.scopes").IsEqual(map[string]string{"read": "read access"})
+	doc.Path("$.securityDefinitions.jwt").IsEqual(map[string]string{"type": "apiKey", "name": "Authorization", "in": "header"})
+	paths := doc.Value("paths").Object()
+	paths.Value("/security").Path("$.get.security").IsEqual([]map[string][]string{
+		{"oauth": {"read"}},
+		{"key": {}},
+	})
+	paths.Value("/security").Path("$.post.security").IsEqual([]map[string][]string{
+		{"jwt": {}},
+		{"basic": {}},
+	})
+	paths.Value("/security/public").Path("$.get").Object().NotContainsKey("security")
+
+	e.GET("/openapi.json").Expect().JSON().Path("$.components.securitySchemes.oauth.flows").
+		IsEqual(map[string]interface{}{"authorizationCode": map[string]interface{}{
+			"authorizationUrl": "/oauth/authorize",
+			"tokenUrl":         "/oauth/token",
+			"scopes":           map[string]string{"read": "read access"},
+		}})
+	redirect := e.GET("/openapi/oauth2-redirect.html").Expect().Status(http.StatusOK).Body().Raw()
+	scripts := regexp.MustCompile(`<script[^>]*\ssrc="([^"]+)"`).FindAllStringSubmatch(redirect, -1)
+	assert.NotEmpty(t, scripts)
+	for _, script := range scripts {
+		e.GET("/openapi/" + script[1]).Expect().Status(http.StatusOK)
+	}
+	index := e.GET("/openapi/").Expect().Status(http.StatusOK).Body()
+	index.Contains(`oauth2RedirectUrl: base+"/oauth2-redirect.html"`)
+	index.Contains(`clientId: "client"`)
+	index.Match(`usePkceWithAuthorizationCodeGrant:\s*true`)
+}
+
+func TestSwaggerDefaultAuth(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "return", Controller: returnCtl{}})
+	e := httpexpect.Default(t, swaggerURL(t, c))
+	c.Add(c.NewSwaggerService(biu.SwaggerInfo{}))
+	e.GET("/swagger.json").Expect().JSON().Object().NotContainsKey("securityDefinitions")
+	e.GET("/swagger/").Expect().Body().NotContains("initOAuth")
+}
+
+type exampleUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func (exampleUser) Examples() map[string]interface{} {
+	return map[string]interface{}{
+		"tom":   exampleUser{ID: 1, Name: "tom"},
+		"jerry": exampleUser{ID: 2, Name: "jerry"},
+	}
+}
+
+type exampleCtl struct{}
+
+func (ctl exampleCtl) WebService(ws biu.WS) {
+	ws.Route(ws.PUT("/{id}").Consumes(restful.MIME_JSON), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Path struct {
+			ID int `biu:"example:1"`
+		}
+		Query struct {
+			IDs []int `biu:"name:ids;example:1,2"`
+		}
+		Cookie struct {
+			Session string `biu:"example:abc"`
+		}
+		Body   exampleUser
+		Return func(exampleUser)
+	}) {
+	}))
+}
+
+type driftUser struct {
+	ID int `json:"id"`
+}
+
+func (driftUser) Examples() map[string]interface{} {
+	return map[string]interface{}{"old": map[string]string{"id": "1"}}
+}
+
+type driftCtl struct{}
+
+func (ctl driftCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Query struct {
+			Size int `biu:"example:10;max:5"`
+		}
+		Return func(driftUser)
+	}) {
+	}))
+}
+
+func TestSwaggerExamples(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "example", Controller: exampleCtl{}})
+	assert.NoError(t, c.ValidateExamples())
+	c.Add(c.NewOpenAPIService(biu.SwaggerInfo{}))
+	doc := swaggerJSON(t, c)
+	put := doc.Value("paths").Object().Value("/example/{id}").Path("$.put").Object()
+	params := put.Value("parameters").Array()
+	params.Value(0).Object().Value("x-example").IsEqual(1)
+	params.Value(1).Object().Value("x-example").IsEqual([]int{1, 2})
+	params.Value(2).Object().Value("in").IsEqual("body")
+	params.Value(2).Object().Value("x-examples").IsEqual(map[string]interface{}{
+		"application/json": map[string]interface{}{"id": 2, "name": "jerry"},
+	})
+	put.Path("$.responses").Object().Value("200").Object().Value("examples").IsEqual(map[string]interface{}{
+		"application/json": map[string]interface{}{
+			"code": 0, "message": "", "data": map[string]interface{}{"id": 2, "name": "jerry"},
+		},
+	})
+
+	oas := httpexpect.Default(t, swaggerURL(t, c)).GET("/openapi.json").Expect().JSON().Object()
+	op := oas.Value("paths").Object().Value("/example/{id}").Path("$.put").Object()
+	op.Value("parameters").Array().Last().Object().ContainsSubset(map[string]interface{}{"in": "cookie", "example": "abc"})
+	op.Path("$.requestBody.content").Object().Value("application/json").Object().Value("examples").
+		IsEqual(map[string]interface{}{
+			"tom":   map[string]interface{}{"value": map[string]interface{}{"id": 1, "name": "tom"}},
+			"jerry": map[string]interface{}{"value": map[string]interface{}{"id": 2, "name": "jerry"}},
+		})
+	resp := op.Path("$.responses").Object().Value("200").Object()
+	resp.NotContainsKey("x-biu-examples")
+	resp.Path("$.content").Object().Value("application/json").Object().Value("examples").Object().
+		Value("tom").Object().Value("value").Object().Value("data").IsEqual(map[string]interface{}{"id": 1, "name": "tom"})
+}
+
+func TestValidateExamples(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil, biu.NS{NameSpace: "drift", Controller: driftCtl{}})
+	err := c.ValidateExamples()
+	assert.ErrorContains(t, err, "GET /drift parameter size: (root): Must be less than or equal to 5")
+	assert.ErrorContains(t, err, "GET /drift response 200 application/json example old: data.id: Invalid type.")
+}
+
+type badExample struct{}
+
+func (badExample) Examples() map[string]interface{} {
+	return map[string]interface{}{"func": func() {}}
+}
+
+type badExampleCtl struct{}
+
+func (ctl badExampleCtl) WebService(ws biu.WS) {
+	ws.Route(ws.POST("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Body badExample
+	}) {
+	}))
+}
+
+func TestBuildSwagger(t *testing.T) {
+	c := biu.New()
+	c.AddServices("/v1", nil, biu.NS{NameSpace: "example", Controller: exampleCtl{}})
+	swo, err := c.BuildSwagger(biu.SwaggerInfo{Title: "title"})
+	assert.NoError(t, err)
+	assert.Equal(t, "title", swo.Info.Title)
+	assert.Contains(t, swo.Paths.Paths, "/v1/example/{id}")
+	doc, err := c.BuildOpenAPI(biu.SwaggerInfo{Title: "title"})
+	assert.NoError(t, err)
+	assert.Equal(t, "title", doc.Info.Title)
+	assert.Contains(t, doc.Paths, "/v1/example/{id}")
+
+	sc := biu.New()
+	sc.AddServices("", nil, biu.NS{NameSpace: "security", Controller: securityCtl{}})
+	_, err = sc.BuildSwagger(biu.SwaggerInfo{SecuritySchemes: map[string]*spec.SecurityScheme{
+		"oauth": spec.OAuth2AccessToken("/oauth/authorize", "/oauth/token"),
+	}})
+	assert.ErrorContains(t, err, `security scheme "key" of GET /security is not in SwaggerInfo.SecuritySchemes`)
+	assert.ErrorContains(t, err, `security scheme "basic" of POST /security is not in SwaggerInfo.SecuritySchemes`)
+	_, err = sc.BuildOpenAPI(biu.SwaggerInfo{})
+	assert.ErrorContains(t, err, `security scheme "oauth"`)
+
+	c.AddServices("/v1", nil, biu.NS{NameSpace: "bad", Controller: badExampleCtl{}})
+	_, err = c.BuildSwagger(biu.SwaggerInfo{})
+	assert.ErrorContains(t, err, "encode swagger")
+	_, err = c.BuildOpenAPI(biu.SwaggerInfo{})
+	assert.ErrorContains(t, err, "encode swagger")
+}
+
+func TestDocsUI(t *testing.T) {
+	c := biu.New()
+	c.Add(c.NewSwaggerService(biu.SwaggerInfo{
+		DarkTheme: true,
+		Specs:     []biu.DocSpec{{Name: "v1", URL: "/v1.json"}, {Name: "v2", URL: "/v2.json"}},
+	}))
+	c.Add(c.NewOpenAPIService(biu.SwaggerInfo{UI: biu.DocsUIReDoc, RouteSuffix: "redoc",
+		UIAssetsURL: "/assets/redoc.js", UIAssetsIntegrity: "sha384-test"}))
+	c.Add(c.NewOpenAPIService(biu.SwaggerInfo{UI: biu.DocsUIScalar, RouteSuffix: "scalar", DarkTheme: true}))
+	c.Add(c.NewOpenAPIService(biu.SwaggerInfo{UI: "unknown", RouteSuffix: "unknown"}))
+	e := httpexpect.Default(t, swaggerURL(t, c))
+
+	index := e.GET("/swagger/").Expect().Status(http.StatusOK).Body()
+	index.Contains(`urls: [{"name":"v1","url":"/v1.json"},{"name":"v2","url":"/v2.json"}],`)
+	index.Contains("filter: invert(88%)")
+	e.GET("/swagger/swagger-ui.css").Expect().Status(http.StatusOK)
+	e.GET("/swagger/redoc.html").Expect().Status(http.StatusNotFound)
+
+	redoc := e.GET("/redoc/").Expect().Status(http.StatusOK).Body()
+	redoc.Contains(`<script src="/assets/redoc.js" integrity="sha384-test" crossorigin="anonymous">`)
+	redoc.Contains("Redoc.init")
+	redoc.NotContains("nestedBackground")
+	e.GET("/redoc.json").Expect().Status(http.StatusOK).JSON().Path("$.openapi").IsEqual("3.1.0")
+
+	scalar := e.GET("/scalar/index.html").Expect().Status(http.StatusOK).Body()
+	scalar.Contains(`<script src="https://cdn.jsdelivr.net/npm/@scalar/api-reference@1.34.0/dist/browser/standalone.js">`)
+	scalar.Contains(`url: base+".json"`)
+	scalar.Match(`darkMode:\s*true`)
+
+	e.GET("/unknown/").Expect().Status(http.StatusOK).Body().Contains("SwaggerUIBundle")
+}
+
+type groupUser struct {
+	Name string `json:"name"`
+}
+
+type groupStats struct {
+	Count int `json:"count"`
+}
+
+type groupSecret struct {
+	Key string `json:"key"`
+}
+
+type secretCtl struct{}
+
+func (ctl secretCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(groupSecret)
+	}) {
+	}))
+}
+
+type groupCtl struct{}
+
+func (ctl groupCtl) WebService(ws biu.WS) {
+	ws.Route(ws.GET("/"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(groupUser)
+	}) {
+	}))
+	ws.Route(ws.GET("/stats"), opt.RouteAPI(func(ctx box.Ctx, api struct {
+		Return func(groupStats)
+	}) {
+	}))
+}
+
+func TestDocGroups(t *testing.T) {
+	c := biu.New()
+	c.AddServices("", nil,
+		biu.NS{NameSpace: "users", Controller: groupCtl{}},
+		biu.NS{NameSpace: "admin", Controller: groupCtl{}},
+		biu.NS{NameSpace: "other", Controller: secretCtl{}},
+	)
+	info := biu.SwaggerInfo{Groups: []biu.DocGroup{
+		{Name: "public", NameSpaces: []string{"users"}},
+		{Name: "Admin", Path: "admin", Tags: []string{"admin"}, Match: func(r restful.Route) bool {
+			return r.Path == "/users/stats"
+		}},
+	}}
+	c.Add(c.NewSwaggerService(info))
+	c.Add(c.NewOpenAPIService(info))
+	e := httpexpect.Default(t, swaggerURL(t, c))
+
+	all := e.GET("/swagger.json").Expect().JSON().Object()
+	all.Value("paths").Object().Keys().ContainsOnly("/users", "/users/stats", "/admin", "/admin/stats")
+	all.Value("tags").Array().Length().IsEqual(2)
+	all.Value("definitions").Object().Keys().NotContains("biu_test.groupSecret")
+
+	public := e.GET("/swagger/public.json").Expect().Status(http.StatusOK).JSON().Object()
+	public.Value("paths").Object().Keys().ContainsOnly("/users", "/users/stats")
+	public.Value("tags").IsEqual([]map[string]string{{"name": "users"}})
+	public.Value("definitions").Object().Keys().ContainsAll("biu_test.groupUser", "biu_test.groupStats")
+
+	admin := e.GET("/openapi/admin.json").Expect().Status(http.StatusOK).JSON().Object()
+	admin.Value("openapi").IsEqual("3.1.0")
+	admin.Value("paths").Object().Keys().ContainsOnly("/admin", "/admin/stats", "/users/stats")
+	e.GET("/openapi/other.json").Expect().Status(http.StatusNotFound)
+
+	e.GET("/swagger/").Expect().Body().
+		Contains(`urls: [{"name":"public","url":"./public.json"},{"name":"Admin","url":"./admin.json"}],`)
+
+	_, err := c.BuildSwagger(biu.SwaggerInfo{Groups: []biu.DocGroup{
+		{Name: "users"}, {Name: "Users", Path: "users"}, {Name: "a/b"}, {},
+	}})
+	assert.ErrorContains(t, err, `duplicated path "users" of doc groups`)
+	assert.ErrorContains(t, err, `invalid path "a/b" of doc group "a/b"`)
+	assert.ErrorContains(t, err, `invalid path "" of doc group ""`)
+}
@@ -0,0 +1,175 @@
+package tus
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileStorage stores uploads in a local directory,
+// each upload has a data file and an info file.
+type FileStorage struct {
+	dir   string
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewFileStorage creates a FileStorage in dir.
+func NewFileStorage(dir string) (*FileStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileStorage{
+		dir:   dir,
+		locks: make(map[string]*sync.Mutex),
+	}, nil
+}
+
+// Create implements Storage.
+func (s *FileStorage) Create(info Info) (string, error) {
+	id, err := newID()
+	if err != nil {
+		return "", err
+	}
+	info.ID = id
+	info.Offset = 0
+	bs, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(s.infoPath(id), bs, 0o644); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(s.dataPath(id), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return "", err
+	}
+	return id, f.Close()
+}
+
+// Info implements Storage.
+func (s *FileStorage) Info(id string) (Info, error) {
+	if !validID(id) {
+		return Info{}, ErrNotFound
+	}
+	bs, err := os.ReadFile(s.infoPath(id))
+	if err != nil {
+		return Info{}, notFound(err)
+	}
+	var info Info
+	if err := json.Unmarshal(bs, &info); err != nil {
+		return Info{}, err
+	}
+	stat, err := os.Stat(s.dataPath(id))
+	if err != nil {
+		return Info{}, notFound(err)
+	}
+	info.Offset = stat.Size()
+	return info, nil
+}
+
+// Write implements Storage.
+func (s *FileStorage) Write(id string, offset int64, src io.Reader) (int64, error) {
+	if !validID(id) {
+		return 0, ErrNotFound
+	}
+	lock := s.lock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	info, err := s.Info(id)
+	if err != nil {
+		return 0, err
+	}
+	if info.Offset != offset {
+		return 0, ErrOffsetMismatch
+	}
+	f, err := os.OpenFile(s.dataPath(id), os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return 0, notFound(err)
+	}
+	n, err := io.Copy(f, io.LimitReader(src, info.Size-offset))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+// Open implements Storage.
+func (s *FileStorage) Open(id string) (io.ReadCloser, error) {
+	if !validID(id) {
+		return nil, ErrNotFound
+	}
+	f, err := os.Open(s.dataPath(id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return f, nil
+}
+
+// Delete implements Storage.
+func (s *FileStorage) Delete(id string) error {
+	if !validID(id) {
+		return ErrNotFound
+	}
+	lock := s.lock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := os.Remove(s.infoPath(id)); err != nil {
+		return notFound(err)
+	}
+	s.mu.Lock()
+	delete(s.locks, id)
+	s.mu.Unlock()
+	return notFound(os.Remove(s.dataPath(id)))
+}
+
+func (s *FileStorage) lock(id string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.locks[id]
+	if !ok {
+		l = new(sync.Mutex)
+		s.locks[id] = l
+	}
+	return l
+}
+
+func (s *FileStorage) dataPath(id string) string {
+	return filepath.Join(s.dir, id)
+}
+
+func (s *FileStorage) infoPath(id string) string {
+	return filepath.Join(s.dir, id+".info")
+}
+
+func newID() (string, error) {
+	bs := make([]byte, 16)
+	if _, err := rand.Read(bs); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bs), nil
+}
+
+// validID prevents path traversal by ids from request.
+func validID(id string) bool {
+	if len(id) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
+
+func notFound(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
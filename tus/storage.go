@@ -0,0 +1,47 @@
+package tus
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	// ErrNotFound is returned by Storage when the upload does not exist.
+	ErrNotFound = errors.New("upload not found")
+	// ErrOffsetMismatch is returned by Storage.Write
+	// when the offset is not equal to the current offset of upload.
+	ErrOffsetMismatch = errors.New("upload offset mismatch")
+)
+
+// Info describes an upload.
+type Info struct {
+	ID string
+	// Size is the total length of upload in bytes.
+	Size int64
+	// Offset is the number of bytes received.
+	Offset int64
+	// Metadata is decoded from the Upload-Metadata header.
+	Metadata map[string]string
+	// Owner is the UserID who created the upload.
+	Owner string
+}
+
+// Completed reports whether all the bytes of upload are received.
+func (i Info) Completed() bool {
+	return i.Offset >= i.Size
+}
+
+// Storage stores the uploads, implementations must be safe for concurrent use.
+type Storage interface {
+	// Create creates a new upload and returns its ID.
+	Create(info Info) (id string, err error)
+	// Info returns the info of an upload.
+	Info(id string) (Info, error)
+	// Write appends the data of src to an upload at offset,
+	// and returns the number of bytes written.
+	Write(id string, offset int64, src io.Reader) (int64, error)
+	// Open opens the data of an upload for reading.
+	Open(id string) (io.ReadCloser, error)
+	// Delete removes an upload.
+	Delete(id string) error
+}
@@ -0,0 +1,274 @@
+// Package tus implements the tus resumable upload protocol 1.0.0
+// with the creation and termination extensions.
+//
+// See https://tus.io/protocols/resumable-upload for the protocol.
+package tus
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/log"
+	"github.com/tuotoo/biu/opt"
+)
+
+const (
+	// Version is the supported version of tus protocol.
+	Version = "1.0.0"
+	// Extensions are the supported extensions of tus protocol.
+	Extensions = "creation,termination"
+	// MIME_OFFSET_OCTET is the content type of PATCH requests.
+	MIME_OFFSET_OCTET = "application/offset+octet-stream"
+
+	headerResumable = "Tus-Resumable"
+	headerVersion   = "Tus-Version"
+	headerExtension = "Tus-Extension"
+	headerMaxSize   = "Tus-Max-Size"
+	headerOffset    = "Upload-Offset"
+	headerLength    = "Upload-Length"
+	headerMetadata  = "Upload-Metadata"
+)
+
+// Controller serves the tus protocol, it can be mounted by biu.NS:
+//
+//	biu.AddServices("/v1", opt.ServicesFuncArr{
+//		opt.Filters(biu.AuthFilter(100, authInstance)),
+//	}, biu.NS{
+//		NameSpace:  "files",
+//		Controller: &tus.Controller{Storage: storage},
+//	})
+type Controller struct {
+	Storage Storage
+	// MaxSize is the max length of an upload, 0 means unlimited.
+	MaxSize int64
+	// OnComplete is called when all the bytes of an upload are received,
+	// ctx is the context of the last PATCH request.
+	OnComplete func(ctx box.Ctx, info Info, file io.Reader)
+}
+
+// WebService implements biu.CtlInterface.
+func (ctl *Controller) WebService(ws biu.WS) {
+	idParam := ws.PathParameter("id", "upload id")
+	ws.Route(ws.OPTIONS("/").Doc("tus server capabilities"),
+		opt.RouteID("tus.options"),
+		ctl.handle(ctl.options),
+	)
+	ws.Route(ws.POST("/").Doc("create an upload").
+		Consumes(restful.MIME_OCTET, MIME_OFFSET_OCTET).
+		Param(ws.HeaderParameter(headerLength, "length of upload").DataType("integer").Required(true)).
+		Param(ws.HeaderParameter(headerMetadata, "base64 encoded metadata")),
+		opt.RouteID("tus.create"),
+		ctl.handle(ctl.create),
+	)
+	ws.Route(ws.HEAD("/{id}").Doc("get the offset of an upload").Param(idParam),
+		opt.RouteID("tus.head"),
+		ctl.handle(ctl.head),
+	)
+	ws.Route(ws.PATCH("/{id}").Doc("upload data at offset").Param(idParam).
+		Consumes(MIME_OFFSET_OCTET).
+		Param(ws.HeaderParameter(headerOffset, "offset of data").DataType("integer").Required(true)),
+		opt.RouteID("tus.patch"),
+		ctl.handle(ctl.patch),
+	)
+	ws.Route(ws.DELETE("/{id}").Doc("terminate an upload").Param(idParam),
+		opt.RouteID("tus.delete"),
+		ctl.handle(ctl.delete),
+	)
+}
+
+// handle checks the protocol version and marks the response as raw.
+func (ctl *Controller) handle(f func(ctx box.Ctx)) opt.RouteFunc {
+	return opt.RouteTo(func(ctx box.Ctx) {
+		ctx.RawResponse()
+		header := ctx.Resp().Header()
+		header.Set(headerResumable, Version)
+		if ctx.Req().Method != http.MethodOptions && ctx.HeaderParameter(headerResumable) != Version {
+			header.Set(headerVersion, Version)
+			ctx.Response.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		f(ctx)
+	})
+}
+
+func (ctl *Controller) options(ctx box.Ctx) {
+	header := ctx.Resp().Header()
+	header.Set(headerVersion, Version)
+	header.Set(headerExtension, Extensions)
+	if ctl.MaxSize > 0 {
+		header.Set(headerMaxSize, strconv.FormatInt(ctl.MaxSize, 10))
+	}
+	ctx.Response.WriteHeader(http.StatusNoContent)
+}
+
+func (ctl *Controller) create(ctx box.Ctx) {
+	size, err := strconv.ParseInt(ctx.HeaderParameter(headerLength), 10, 64)
+	if err != nil || size < 0 {
+		writeError(ctx, http.StatusBadRequest, "invalid "+headerLength)
+		return
+	}
+	if ctl.MaxSize > 0 && size > ctl.MaxSize {
+		writeError(ctx, http.StatusRequestEntityTooLarge, "upload is too large")
+		return
+	}
+	metadata, err := parseMetadata(ctx.HeaderParameter(headerMetadata))
+	if err != nil {
+		writeError(ctx, http.StatusBadRequest, "invalid "+headerMetadata)
+		return
+	}
+	info := Info{
+		Size:     size,
+		Metadata: metadata,
+		Owner:    ctx.UserID(),
+	}
+	info.ID, err = ctl.Storage.Create(info)
+	if err != nil {
+		ctl.storageError(ctx, err)
+		return
+	}
+	location := strings.TrimRight(ctx.Req().URL.Path, "/") + "/" + info.ID
+	ctx.Resp().Header().Set("Location", location)
+	ctx.Response.WriteHeader(http.StatusCreated)
+	if size == 0 {
+		ctl.complete(ctx, info)
+	}
+}
+
+func (ctl *Controller) head(ctx box.Ctx) {
+	info, ok := ctl.info(ctx)
+	if !ok {
+		return
+	}
+	header := ctx.Resp().Header()
+	header.Set("Cache-Control", "no-store")
+	header.Set(headerOffset, strconv.FormatInt(info.Offset, 10))
+	header.Set(headerLength, strconv.FormatInt(info.Size, 10))
+	if len(info.Metadata) > 0 {
+		header.Set(headerMetadata, formatMetadata(info.Metadata))
+	}
+	ctx.Response.WriteHeader(http.StatusOK)
+}
+
+func (ctl *Controller) patch(ctx box.Ctx) {
+	offset, err := strconv.ParseInt(ctx.HeaderParameter(headerOffset), 10, 64)
+	if err != nil || offset < 0 {
+		writeError(ctx, http.StatusBadRequest, "invalid "+headerOffset)
+		return
+	}
+	info, ok := ctl.info(ctx)
+	if !ok {
+		return
+	}
+	if offset != info.Offset {
+		writeError(ctx, http.StatusConflict, "offset mismatch")
+		return
+	}
+	n, err := ctl.Storage.Write(info.ID, offset, ctx.Req().Body)
+	if errors.Is(err, ErrOffsetMismatch) {
+		writeError(ctx, http.StatusConflict, "offset mismatch")
+		return
+	}
+	if err != nil {
+		ctl.storageError(ctx, err)
+		return
+	}
+	info.Offset = offset + n
+	ctx.Resp().Header().Set(headerOffset, strconv.FormatInt(info.Offset, 10))
+	ctx.Response.WriteHeader(http.StatusNoContent)
+	if info.Completed() {
+		ctl.complete(ctx, info)
+	}
+}
+
+func (ctl *Controller) delete(ctx box.Ctx) {
+	info, ok := ctl.info(ctx)
+	if !ok {
+		return
+	}
+	if err := ctl.Storage.Delete(info.ID); err != nil {
+		ctl.storageError(ctx, err)
+		return
+	}
+	ctx.Response.WriteHeader(http.StatusNoContent)
+}
+
+// info loads the upload of path parameter,
+// uploads of other users are treated as not found.
+func (ctl *Controller) info(ctx box.Ctx) (Info, bool) {
+	info, err := ctl.Storage.Info(ctx.PathParameter("id"))
+	if err == nil && info.Owner != "" && info.Owner != ctx.UserID() {
+		err = ErrNotFound
+	}
+	if err != nil {
+		ctl.storageError(ctx, err)
+		return Info{}, false
+	}
+	return info, true
+}
+
+func (ctl *Controller) complete(ctx box.Ctx, info Info) {
+	if ctl.OnComplete == nil {
+		return
+	}
+	f, err := ctl.Storage.Open(info.ID)
+	if err != nil {
+		ctx.Logger.Info(log.BiuInternalInfo{
+			Err:    err,
+			Extras: map[string]interface{}{"uploadID": info.ID},
+		})
+		return
+	}
+	defer f.Close()
+	ctl.OnComplete(ctx, info, f)
+}
+
+func (ctl *Controller) storageError(ctx box.Ctx, err error) {
+	if errors.Is(err, ErrNotFound) {
+		writeError(ctx, http.StatusNotFound, err.Error())
+		return
+	}
+	ctx.Logger.Info(log.BiuInternalInfo{
+		Err:    err,
+		Extras: map[string]interface{}{"routeSig": ctx.RouteSignature()},
+	})
+	writeError(ctx, http.StatusInternalServerError, "storage error")
+}
+
+func writeError(ctx box.Ctx, status int, msg string) {
+	_ = ctx.Response.WriteErrorString(status, msg)
+}
+
+// parseMetadata decodes Upload-Metadata,
+// which consists of comma separated "key base64(value)" pairs.
+func parseMetadata(s string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(pair, " ")
+		bs, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		metadata[key] = string(bs)
+	}
+	return metadata, nil
+}
+
+func formatMetadata(metadata map[string]string) string {
+	pairs := make([]string, 0, len(metadata))
+	for k, v := range metadata {
+		pairs = append(pairs, k+" "+base64.StdEncoding.EncodeToString([]byte(v)))
+	}
+	return strings.Join(pairs, ",")
+}
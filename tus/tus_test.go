@@ -0,0 +1,109 @@
+package tus_test
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuotoo/biu"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+	"github.com/tuotoo/biu/tus"
+)
+
+type completed struct {
+	userID string
+	info   tus.Info
+	data   string
+}
+
+func newServer(t *testing.T) (*httpexpect.Expect, chan completed) {
+	storage, err := tus.NewFileStorage(t.TempDir())
+	assert.NoError(t, err)
+	done := make(chan completed, 1)
+	c := biu.New()
+	c.AddServices("/v1", opt.ServicesFuncArr{
+		opt.Filters(biu.Filter(func(ctx box.Ctx) {
+			userID := ctx.HeaderParameter("X-User")
+			if userID == "" {
+				userID = "u1"
+			}
+			ctx.SetAttribute(box.BiuAttrAuthUserID, userID)
+			ctx.Next()
+		})),
+	}, biu.NS{
+		NameSpace: "files",
+		Controller: &tus.Controller{
+			Storage: storage,
+			MaxSize: 100,
+			OnComplete: func(ctx box.Ctx, info tus.Info, file io.Reader) {
+				bs, err := io.ReadAll(file)
+				assert.NoError(t, err)
+				done <- completed{userID: ctx.UserID(), info: info, data: string(bs)}
+			},
+		},
+	})
+	s := httptest.NewServer(c)
+	t.Cleanup(s.Close)
+	return httpexpect.Default(t, s.URL), done
+}
+
+func TestController(t *testing.T) {
+	raw, done := newServer(t)
+	e := raw.Builder(func(r *httpexpect.Request) {
+		r.WithHeader("Tus-Resumable", tus.Version)
+	})
+
+	opts := e.OPTIONS("/v1/files").Expect().Status(http.StatusNoContent)
+	opts.Header("Tus-Version").IsEqual(tus.Version)
+	opts.Header("Tus-Extension").IsEqual(tus.Extensions)
+	opts.Header("Tus-Max-Size").IsEqual("100")
+
+	e.POST("/v1/files").WithHeader("Upload-Length", "101").
+		Expect().Status(http.StatusRequestEntityTooLarge)
+	raw.POST("/v1/files").WithHeader("Tus-Resumable", "0.2.2").WithHeader("Upload-Length", "10").
+		Expect().Status(http.StatusPreconditionFailed).Header("Tus-Version").IsEqual(tus.Version)
+
+	location := e.POST("/v1/files").
+		WithHeader("Upload-Length", "10").
+		WithHeader("Upload-Metadata", "filename "+base64.StdEncoding.EncodeToString([]byte("a.txt"))+",empty").
+		Expect().Status(http.StatusCreated).
+		Header("Location").NotEmpty().Raw()
+
+	head := e.HEAD(location).Expect().Status(http.StatusOK)
+	head.Header("Upload-Offset").IsEqual("0")
+	head.Header("Upload-Length").IsEqual("10")
+	head.Header("Cache-Control").IsEqual("no-store")
+
+	e.PATCH(location).WithHeader("Upload-Offset", "0").
+		WithHeader("Content-Type", tus.MIME_OFFSET_OCTET).WithText("01234").
+		Expect().Status(http.StatusNoContent).Header("Upload-Offset").IsEqual("5")
+	e.PATCH(location).WithHeader("Upload-Offset", "0").
+		WithHeader("Content-Type", tus.MIME_OFFSET_OCTET).WithText("01234").
+		Expect().Status(http.StatusConflict)
+	e.PATCH(location).WithHeader("Upload-Offset", "5").
+		WithHeader("Content-Type", "text/plain").WithText("56789").
+		Expect().Status(http.StatusUnsupportedMediaType)
+	e.HEAD(location).WithHeader("X-User", "u2").Expect().Status(http.StatusNotFound)
+
+	e.PATCH(location).WithHeader("Upload-Offset", "5").
+		WithHeader("Content-Type", tus.MIME_OFFSET_OCTET).WithText("56789").
+		Expect().Status(http.StatusNoContent).Header("Upload-Offset").IsEqual("10")
+	select {
+	case c := <-done:
+		assert.Equal(t, "u1", c.userID)
+		assert.Equal(t, "0123456789", c.data)
+		assert.Equal(t, map[string]string{"filename": "a.txt", "empty": ""}, c.info.Metadata)
+	default:
+		t.Error("OnComplete is not called")
+	}
+
+	e.DELETE(location).Expect().Status(http.StatusNoContent)
+	e.HEAD(location).Expect().Status(http.StatusNotFound)
+	e.HEAD("/v1/files/..%2F..%2Fetc").Expect().Status(http.StatusNotFound)
+}
@@ -2,6 +2,7 @@ package biu
 
 import (
 	"github.com/emicklei/go-restful/v3"
+	"github.com/go-openapi/spec"
 )
 
 // NS contains configuration of a namespace
@@ -44,4 +45,70 @@ type SwaggerInfo struct {
 	// by default the RouteSuffix is swagger
 	RoutePrefix string
 	RouteSuffix string
+	// SecuritySchemes are the security definitions referenced by opt.Security,
+	// e.g. spec.APIKeyAuth, spec.BasicAuth and spec.OAuth2AccessToken.
+	// An apiKey in cookie is only valid in the OpenAPI 3 document.
+	// The jwt scheme of opt.EnableAuth is added if it is not defined,
+	// other undefined names are returned as an error by BuildSwagger and BuildOpenAPI,
+	// and are fatal when the document services are created.
+	SecuritySchemes map[string]*spec.SecurityScheme
+	// OAuthClientID and OAuthUsePKCE are used by the "Authorize" of swagger ui,
+	// whose redirect url is <RoutePrefix>/<RouteSuffix>/oauth2-redirect.html.
+	OAuthClientID string
+	OAuthUsePKCE  bool
+	// UI is the renderer of the document page, the default is DocsUISwagger,
+	// which is also used with a warning for an unknown UI.
+	// Swagger UI is embedded, but ReDoc and Scalar are not, their pages load
+	// redoc@2.4.0 and @scalar/api-reference@1.34.0 from cdn.jsdelivr.net,
+	// so they are blank for browsers without access to it.
+	// UIAssetsURL replaces the CDN script, e.g. by a copy served in the intranet,
+	// and UIAssetsIntegrity is the SRI hash checked by browsers for the script,
+	// e.g. "sha384-...".
+	UI                DocsUI
+	UIAssetsURL       string
+	UIAssetsIntegrity string
+	// DarkTheme renders the document page in dark colors.
+	DarkTheme bool
+	// Specs are the documents listed in the selector of the document page,
+	// the document of the service is shown if it is empty.
+	Specs []DocSpec
+	// Groups split the routes into documents, each of them is served in
+	// <RoutePrefix>/<RouteSuffix>/<Path>.json and listed in the selector
+	// if Specs is empty. The document of the service has the routes of all groups.
+	// The paths must be unique and have no slash, or building the documents fails.
+	Groups []DocGroup
+
+	// internal marks a document built for validation instead of clients,
+	// whose security schemes are not checked.
+	internal bool
+}
+
+// DocGroup is a document of the routes selected by NameSpaces, Tags or Match,
+// a route is in the group if it matches any of them.
+//
+//	biu.DocGroup{Name: "admin", NameSpaces: []string{"users"}, Tags: []string{"internal"}}
+type DocGroup struct {
+	// Name is shown in the selector of the document page.
+	Name string
+	// Path is the file name of the document without .json, the default is Name.
+	Path       string
+	NameSpaces []string
+	Tags       []string
+	Match      func(restful.Route) bool
+}
+
+// DocsUI is the renderer of the document page.
+type DocsUI string
+
+const (
+	DocsUISwagger DocsUI = "swagger"
+	DocsUIReDoc   DocsUI = "redoc"
+	DocsUIScalar  DocsUI = "scalar"
+)
+
+// DocSpec is a document listed in the selector of the document page,
+// its URL is absolute or relative to the page.
+type DocSpec struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
 }
@@ -0,0 +1,88 @@
+package biu
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/log"
+	"github.com/tuotoo/biu/opt"
+)
+
+// CloseWebSockets sends a going away close message to
+// all the websocket connections of container and closes them.
+// It is called automatically when the server shuts down.
+func (c *Container) CloseWebSockets() {
+	c.socketsMu.Lock()
+	conns := make([]*websocket.Conn, 0, len(c.sockets))
+	for conn := range c.sockets {
+		conns = append(conns, conn)
+	}
+	c.socketsMu.Unlock()
+
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutdown")
+	for _, conn := range conns {
+		_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+		_ = conn.Close()
+	}
+}
+
+func (c *Container) addWebSocket(conn *websocket.Conn) {
+	c.socketsMu.Lock()
+	defer c.socketsMu.Unlock()
+	c.sockets[conn] = struct{}{}
+}
+
+func (c *Container) removeWebSocket(conn *websocket.Conn) {
+	c.socketsMu.Lock()
+	defer c.socketsMu.Unlock()
+	delete(c.sockets, conn)
+}
+
+func (c *Container) webSocketHandler(cfg *opt.WebSocketConfig) func(ctx box.Ctx) {
+	return func(ctx box.Ctx) {
+		ctx.RawResponse()
+		conn, err := cfg.Upgrader.Upgrade(ctx.Resp(), ctx.Req(), nil)
+		if err != nil {
+			// the upgrader has already replied with an HTTP error
+			ctx.Logger.Info(log.BiuInternalInfo{
+				Err:    fmt.Errorf("upgrade websocket: %w", err),
+				Extras: map[string]interface{}{"routeSig": ctx.RouteSignature()},
+			})
+			return
+		}
+		c.addWebSocket(conn)
+		defer c.removeWebSocket(conn)
+		defer conn.Close()
+
+		conn.SetReadLimit(cfg.ReadLimit)
+		if cfg.PongWait > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(cfg.PongWait))
+			conn.SetPongHandler(func(string) error {
+				return conn.SetReadDeadline(time.Now().Add(cfg.PongWait))
+			})
+		}
+		if cfg.PingInterval > 0 {
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				ticker := time.NewTicker(cfg.PingInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(cfg.WriteWait))
+						if err != nil {
+							return
+						}
+					}
+				}
+			}()
+		}
+		cfg.Handler(ctx, conn)
+	}
+}
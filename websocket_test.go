@@ -0,0 +1,87 @@
+package biu
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/tuotoo/biu/auth"
+	"github.com/tuotoo/biu/box"
+	"github.com/tuotoo/biu/opt"
+)
+
+func TestWebSocket(t *testing.T) {
+	c := New()
+	authInstance := &auth.Instance{
+		ITokenManager: MockAuthTokenManager{},
+	}
+	token, err := authInstance.Sign("")
+	assert.NoError(t, err)
+	c.Filter(AuthFilter(100, authInstance))
+	ws := c.NewWS()
+	ws.Route(ws.GET("/stream/{id}"), opt.WebSocket(func(ctx box.Ctx, conn *websocket.Conn) {
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			reply := ctx.UserID() + ":" + ctx.PathParameter("id") + ":" + string(msg)
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(reply)); err != nil {
+				return
+			}
+		}
+	}, opt.WSReadLimit(8), opt.WSKeepAlive(10*time.Millisecond, time.Second)))
+	c.Add(ws.WebService)
+	s := c.NewTestServer()
+
+	_, resp, err := s.DialWebSocket("/stream/7", nil)
+	assert.ErrorIs(t, err, websocket.ErrBadHandshake)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	conn, _, err := s.DialWebSocket("/stream/7?access_token="+token, nil)
+	assert.NoError(t, err)
+	pinged := make(chan struct{}, 1)
+	conn.SetPingHandler(func(string) error {
+		select {
+		case pinged <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("hi")))
+	_, msg, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, "1:7:hi", string(msg))
+
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	select {
+	case <-pinged:
+	case <-time.After(time.Second):
+		t.Error("no ping is received")
+	}
+	conn.Close()
+
+	conn, _, err = s.DialWebSocket("/stream/7?access_token="+token, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("message too long")))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), err)
+	conn.Close()
+
+	conn, _, err = s.DialWebSocket("/stream/7?access_token="+token, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("hi")))
+	_, _, err = conn.ReadMessage()
+	assert.NoError(t, err)
+	s.Close()
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), err)
+}